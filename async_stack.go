@@ -0,0 +1,127 @@
+package promise
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+	"sync/atomic"
+)
+
+var asyncStackTracesEnabled int32
+
+func SetAsyncStackTraces(enabled bool) {
+	if enabled {
+		atomic.StoreInt32(&asyncStackTracesEnabled, 1)
+	} else {
+		atomic.StoreInt32(&asyncStackTracesEnabled, 0)
+	}
+}
+
+func isAsyncStackTracing() bool {
+	return 0 != atomic.LoadInt32(&asyncStackTracesEnabled)
+}
+
+type AsyncError struct {
+	err  error
+	hops []asyncHop
+}
+
+type asyncHop struct {
+	id    uint64
+	name  string
+	kind  HandlerKind
+	stack []uintptr
+}
+
+func (e *AsyncError) Error() string {
+	return e.err.Error()
+}
+
+func (e *AsyncError) Unwrap() error {
+	return e.err
+}
+
+func (e *AsyncError) AsyncStack() string {
+	var stack strings.Builder
+
+	for i, hop := range e.hops {
+		if 0 != i {
+			stack.WriteString("async ")
+		}
+
+		if "" != hop.kind {
+			stack.WriteString(string(hop.kind))
+			stack.WriteString(" ")
+		}
+
+		_, _ = fmt.Fprintf(&stack, "promise #%d", hop.id)
+
+		if "" != hop.name {
+			_, _ = fmt.Fprintf(&stack, " %q", hop.name)
+		}
+
+		stack.WriteString(" created at:\n")
+
+		frames := runtime.CallersFrames(hop.stack)
+
+		for {
+			frame, more := frames.Next()
+
+			if !isPackageFrame(frame) && "" != frame.Function {
+				_, _ = fmt.Fprintf(&stack, "\t%s\n\t\t%s:%d\n", frame.Function, frame.File, frame.Line)
+			}
+
+			if !more {
+				break
+			}
+		}
+	}
+
+	return stack.String()
+}
+
+func (e *AsyncError) Format(f fmt.State, verb rune) {
+	switch verb {
+	case 'v':
+		if f.Flag('+') {
+			_, _ = fmt.Fprintf(f, "%+v\n%s", e.err, e.AsyncStack())
+		} else {
+			_, _ = fmt.Fprint(f, e.Error())
+		}
+
+	case 's':
+		_, _ = fmt.Fprint(f, e.Error())
+
+	case 'q':
+		_, _ = fmt.Fprintf(f, "%q", e.Error())
+
+	default:
+		_, _ = fmt.Fprintf(f, "%%!%c(*promise.AsyncError=%s)", verb, e.Error())
+	}
+}
+
+func (p *Promise) withAsyncStack(reason error) error {
+	if !p.asyncStack || nil == reason {
+		return reason
+	}
+
+	hop := asyncHop{
+		id:    p.id,
+		name:  p.name,
+		kind:  p.kind,
+		stack: p.stack,
+	}
+
+	if asyncErr, ok := reason.(*AsyncError); ok {
+		if last := asyncErr.hops[len(asyncErr.hops)-1]; last.id == p.id {
+			return asyncErr
+		}
+
+		hops := make([]asyncHop, len(asyncErr.hops), len(asyncErr.hops)+1)
+		copy(hops, asyncErr.hops)
+
+		return &AsyncError{err: asyncErr.err, hops: append(hops, hop)}
+	}
+
+	return &AsyncError{err: reason, hops: []asyncHop{hop}}
+}
@@ -0,0 +1,89 @@
+package promise
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/jaswdr/faker"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetAsyncStackTraces(t *testing.T) {
+	fakerInstance := faker.New()
+
+	t.Run("Wraps rejection reasons with the async path", func(t *testing.T) {
+		SetAsyncStackTraces(true)
+		defer SetAsyncStackTraces(false)
+
+		callsStack := newCallsRegistry(1)
+		reason := errors.New(fakerInstance.Lorem().Sentence(6))
+
+		var caughtReason error
+
+		promise := Pending().Named("fetch")
+		thenPromise := promise.Then(func(value interface{}) (interface{}, error) {
+			return nil, reason
+		}).(*Promise)
+		finallyPromise := thenPromise.Finally(func() {}).(*Promise)
+		finallyPromise.Catch(func(reason error) {
+			caughtReason = reason
+
+			callsStack.Register("Catch")
+		})
+
+		require.NoError(t, promise.Resolve(fakerInstance.Int()))
+
+		callsStack.AssertCompletedInOrder(t, []string{"Catch"})
+
+		require.ErrorIs(t, caughtReason, reason)
+		require.Equal(t, reason.Error(), caughtReason.Error())
+		require.Equal(t, reason.Error(), fmt.Sprintf("%v", caughtReason))
+
+		var asyncErr *AsyncError
+
+		require.True(t, errors.As(caughtReason, &asyncErr))
+		require.Same(t, reason, asyncErr.Unwrap())
+		require.Regexp(t, regexp.MustCompile(fmt.Sprintf(
+			`^then promise #%d "fetch\.then" created at:\n\tgithub\.com/donatorsky/go-promise\.TestSetAsyncStackTraces\.func1\n\t\t.+/async_stack_test\.go:\d+\n(?:.+\n)*async finally promise #%d "fetch\.then\.finally" created at:\n\tgithub\.com/donatorsky/go-promise\.TestSetAsyncStackTraces\.func1\n\t\t.+/async_stack_test\.go:\d+\n`,
+			thenPromise.ID(),
+			finallyPromise.ID(),
+		)), asyncErr.AsyncStack())
+		require.Equal(t, reason.Error()+"\n"+asyncErr.AsyncStack(), fmt.Sprintf("%+v", caughtReason))
+	})
+
+	t.Run("Wraps reasons of rejected promises", func(t *testing.T) {
+		SetAsyncStackTraces(true)
+		defer SetAsyncStackTraces(false)
+
+		reason := errors.New(fakerInstance.Lorem().Sentence(6))
+
+		pendingPromise := Pending()
+
+		require.NoError(t, pendingPromise.Reject(reason))
+
+		for _, promise := range []*Promise{Reject(reason), pendingPromise} {
+			var asyncErr *AsyncError
+
+			require.True(t, errors.As(promise.err, &asyncErr))
+			require.ErrorIs(t, asyncErr, reason)
+			require.Regexp(t, fmt.Sprintf(`^promise #%d created at:\n\tgithub\.com/donatorsky/go-promise\.`, promise.ID()), asyncErr.AsyncStack())
+		}
+	})
+
+	t.Run("Does not wrap rejection reasons when disabled", func(t *testing.T) {
+		callsStack := newCallsRegistry(1)
+		reason := errors.New(fakerInstance.Lorem().Sentence(6))
+
+		Reject(reason).Then(func(value interface{}) (interface{}, error) {
+			return value, nil
+		}).Catch(func(caughtReason error) {
+			require.Same(t, reason, caughtReason)
+
+			callsStack.Register("Catch")
+		})
+
+		callsStack.AssertCompletedInOrder(t, []string{"Catch"})
+	})
+}
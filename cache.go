@@ -0,0 +1,164 @@
+package promise
+
+import (
+	"container/list"
+	"runtime/debug"
+	"sync"
+	"time"
+)
+
+type CacheConfig struct {
+	TTL         time.Duration
+	NegativeTTL time.Duration
+	MaxEntries  int
+}
+
+type Cache struct {
+	mutex sync.Mutex
+
+	rt     *Runtime
+	clock  Clock
+	config CacheConfig
+
+	entries map[interface{}]*list.Element
+	lru     *list.List
+}
+
+type cacheEntry struct {
+	key       interface{}
+	promise   *Promise
+	isSettled bool
+	expiresAt time.Time
+}
+
+func NewCache(config CacheConfig) *Cache {
+	return defaultRuntime.NewCache(config)
+}
+
+func (rt *Runtime) NewCache(config CacheConfig) *Cache {
+	return &Cache{
+		rt:      rt,
+		clock:   rt.makeOptions(nil).clock,
+		config:  config,
+		entries: make(map[interface{}]*list.Element),
+		lru:     list.New(),
+	}
+}
+
+func (c *Cache) Get(key interface{}, loader func(key interface{}) Promiser) *Promise {
+	c.mutex.Lock()
+
+	if element, exists := c.entries[key]; exists {
+		entry := element.Value.(*cacheEntry)
+
+		if !entry.isExpired(c.clock.Now()) {
+			c.lru.MoveToFront(element)
+			c.mutex.Unlock()
+
+			return entry.promise
+		}
+
+		c.remove(element)
+	}
+
+	entry := &cacheEntry{key: key, promise: c.rt.Pending()}
+	element := c.lru.PushFront(entry)
+	c.entries[key] = element
+
+	for 0 < c.config.MaxEntries && c.lru.Len() > c.config.MaxEntries {
+		c.remove(c.lru.Back())
+	}
+
+	c.mutex.Unlock()
+
+	entry.promise.onSettled(func() {
+		c.settle(element)
+	})
+
+	isLoaded := false
+
+	defer func() {
+		if isLoaded {
+			return
+		}
+
+		recovered := recover()
+
+		c.mutex.Lock()
+		if current, exists := c.entries[key]; exists && current == element {
+			c.remove(element)
+		}
+		c.mutex.Unlock()
+
+		_ = entry.promise.Reject(&PanicError{Value: recovered, Stack: debug.Stack()})
+
+		if nil != recovered {
+			panic(recovered)
+		}
+	}()
+
+	loaded := loader(key)
+	isLoaded = true
+
+	_ = entry.promise.Resolve(loaded)
+
+	return entry.promise
+}
+
+func (c *Cache) Delete(key interface{}) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if element, exists := c.entries[key]; exists {
+		c.remove(element)
+	}
+}
+
+func (c *Cache) Len() int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.lru.Len()
+}
+
+func (c *Cache) settle(element *list.Element) {
+	entry := element.Value.(*cacheEntry)
+
+	entry.promise.mutex.RLock()
+	state := entry.promise.state
+	entry.promise.mutex.RUnlock()
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if current, exists := c.entries[entry.key]; !exists || current != element {
+		return
+	}
+
+	ttl := c.config.TTL
+
+	if StateRejected == state {
+		if 0 >= c.config.NegativeTTL {
+			c.remove(element)
+
+			return
+		}
+
+		ttl = c.config.NegativeTTL
+	}
+
+	entry.isSettled = true
+
+	if 0 < ttl {
+		entry.expiresAt = c.clock.Now().Add(ttl)
+	}
+}
+
+func (c *Cache) remove(element *list.Element) {
+	delete(c.entries, element.Value.(*cacheEntry).key)
+	c.lru.Remove(element)
+}
+
+func (e *cacheEntry) isExpired(now time.Time) bool {
+	return e.isSettled && !e.expiresAt.IsZero() && !now.Before(e.expiresAt)
+}
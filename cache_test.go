@@ -0,0 +1,215 @@
+package promise
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jaswdr/faker"
+	"github.com/stretchr/testify/require"
+)
+
+type countingLoader struct {
+	calls   map[interface{}]int
+	promise func(key interface{}) Promiser
+}
+
+func newCountingLoader(promise func(key interface{}) Promiser) *countingLoader {
+	return &countingLoader{
+		calls:   make(map[interface{}]int),
+		promise: promise,
+	}
+}
+
+func (l *countingLoader) Load(key interface{}) Promiser {
+	l.calls[key]++
+
+	return l.promise(key)
+}
+
+func TestCache(t *testing.T) {
+	fakerInstance := faker.New()
+
+	newCache := func(config CacheConfig) (*Cache, *fakeClock) {
+		clock := &fakeClock{now: time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)}
+
+		return NewRuntime(WithClock(clock)).NewCache(config), clock
+	}
+
+	t.Run("Shares in-flight promise between callers", func(t *testing.T) {
+		cache, _ := newCache(CacheConfig{})
+
+		value := fakerInstance.Int()
+		pending := Pending()
+		loader := newCountingLoader(func(_ interface{}) Promiser {
+			return pending
+		})
+
+		first := cache.Get("key", loader.Load)
+		second := cache.Get("key", loader.Load)
+
+		require.Same(t, first, second)
+		require.Equal(t, StatePending, first.State())
+		require.Equal(t, 1, loader.calls["key"])
+
+		require.NoError(t, pending.Resolve(value))
+
+		require.Equal(t, StateFulfilled, first.State())
+		require.Equal(t, value, first.value)
+	})
+
+	t.Run("Keeps values until TTL passes", func(t *testing.T) {
+		cache, clock := newCache(CacheConfig{TTL: time.Minute})
+
+		loader := newCountingLoader(func(key interface{}) Promiser {
+			return Resolve(key)
+		})
+
+		first := cache.Get("key", loader.Load)
+
+		clock.Advance(59 * time.Second)
+
+		require.Same(t, first, cache.Get("key", loader.Load))
+		require.Equal(t, 1, loader.calls["key"])
+
+		clock.Advance(time.Second)
+
+		second := cache.Get("key", loader.Load)
+
+		require.NotSame(t, first, second)
+		require.Equal(t, "key", second.value)
+		require.Equal(t, 2, loader.calls["key"])
+	})
+
+	t.Run("Keeps values forever without TTL", func(t *testing.T) {
+		cache, clock := newCache(CacheConfig{})
+
+		loader := newCountingLoader(func(key interface{}) Promiser {
+			return Resolve(key)
+		})
+
+		first := cache.Get("key", loader.Load)
+
+		clock.Advance(24 * time.Hour)
+
+		require.Same(t, first, cache.Get("key", loader.Load))
+		require.Equal(t, 1, loader.calls["key"])
+	})
+
+	t.Run("Evicts rejections", func(t *testing.T) {
+		cache, _ := newCache(CacheConfig{TTL: time.Minute})
+
+		reason := errors.New(fakerInstance.Lorem().Sentence(6))
+		loader := newCountingLoader(func(_ interface{}) Promiser {
+			return Reject(reason)
+		})
+
+		first := cache.Get("key", loader.Load)
+
+		require.Equal(t, StateRejected, first.State())
+		require.Same(t, reason, first.err)
+		require.Equal(t, 0, cache.Len())
+
+		require.NotSame(t, first, cache.Get("key", loader.Load))
+		require.Equal(t, 2, loader.calls["key"])
+	})
+
+	t.Run("Keeps rejections until negative TTL passes", func(t *testing.T) {
+		cache, clock := newCache(CacheConfig{TTL: time.Hour, NegativeTTL: time.Second})
+
+		loader := newCountingLoader(func(_ interface{}) Promiser {
+			return Reject(errors.New(fakerInstance.Lorem().Sentence(6)))
+		})
+
+		first := cache.Get("key", loader.Load)
+
+		require.Same(t, first, cache.Get("key", loader.Load))
+
+		clock.Advance(time.Second)
+
+		require.NotSame(t, first, cache.Get("key", loader.Load))
+		require.Equal(t, 2, loader.calls["key"])
+	})
+
+	t.Run("Evicts least recently used entries", func(t *testing.T) {
+		cache, _ := newCache(CacheConfig{MaxEntries: 2})
+
+		loader := newCountingLoader(func(key interface{}) Promiser {
+			return Resolve(key)
+		})
+
+		cache.Get("a", loader.Load)
+		cache.Get("b", loader.Load)
+		cache.Get("a", loader.Load)
+		cache.Get("c", loader.Load)
+
+		require.Equal(t, 2, cache.Len())
+
+		cache.Get("a", loader.Load)
+		cache.Get("c", loader.Load)
+		cache.Get("b", loader.Load)
+
+		require.Equal(t, map[interface{}]int{"a": 1, "b": 2, "c": 1}, loader.calls)
+	})
+
+	t.Run("Deletes entries", func(t *testing.T) {
+		cache, _ := newCache(CacheConfig{})
+
+		loader := newCountingLoader(func(key interface{}) Promiser {
+			return Resolve(key)
+		})
+
+		first := cache.Get("key", loader.Load)
+
+		cache.Delete("key")
+		cache.Delete("missing")
+
+		require.NotSame(t, first, cache.Get("key", loader.Load))
+		require.Equal(t, 2, loader.calls["key"])
+	})
+
+	t.Run("Does not cache results of deleted in-flight loads", func(t *testing.T) {
+		cache, _ := newCache(CacheConfig{})
+
+		pending := Pending()
+		loader := newCountingLoader(func(_ interface{}) Promiser {
+			return pending
+		})
+
+		cache.Get("key", loader.Load)
+		cache.Delete("key")
+
+		require.NoError(t, pending.Resolve(nil))
+
+		require.Equal(t, 0, cache.Len())
+	})
+	t.Run("Rejects shared promise and evicts the entry when loader panics", func(t *testing.T) {
+		cache, _ := newCache(CacheConfig{})
+
+		value := fakerInstance.Int()
+
+		var shared *Promise
+
+		require.PanicsWithValue(t, "boom", func() {
+			cache.Get("key", func(key interface{}) Promiser {
+				shared = cache.Get(key, nil)
+
+				panic("boom")
+			})
+		})
+
+		var panicErr *PanicError
+
+		require.Equal(t, StateRejected, shared.State())
+		require.True(t, errors.As(shared.err, &panicErr))
+		require.Equal(t, "boom", panicErr.Value)
+		require.Zero(t, cache.Len())
+
+		promise := cache.Get("key", func(_ interface{}) Promiser {
+			return Resolve(value)
+		})
+
+		require.Equal(t, StateFulfilled, promise.State())
+		require.Equal(t, value, promise.value)
+	})
+}
@@ -0,0 +1,203 @@
+package promise
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+)
+
+const chromeTraceProcessID = 1
+
+type chromeTrace struct {
+	TraceEvents     []chromeTraceEvent `json:"traceEvents"`
+	DisplayTimeUnit string             `json:"displayTimeUnit"`
+}
+
+type chromeTraceEvent struct {
+	Name      string                 `json:"name"`
+	Category  string                 `json:"cat,omitempty"`
+	Phase     string                 `json:"ph"`
+	Timestamp float64                `json:"ts"`
+	Duration  *float64               `json:"dur,omitempty"`
+	ProcessID int                    `json:"pid"`
+	ThreadID  uint64                 `json:"tid"`
+	ID        uint64                 `json:"id,omitempty"`
+	Binding   string                 `json:"bp,omitempty"`
+	Args      map[string]interface{} `json:"args,omitempty"`
+}
+
+type chromeTraceLane struct {
+	id        uint64
+	name      string
+	createdAt time.Time
+	settledAt time.Time
+	state     State
+}
+
+func (r *TraceRecorder) WriteChromeTrace(w io.Writer) error {
+	return WriteChromeTrace(w, r.Events())
+}
+
+func WriteChromeTrace(w io.Writer, events []TraceEvent) error {
+	trace := chromeTrace{
+		TraceEvents:     []chromeTraceEvent{},
+		DisplayTimeUnit: "ms",
+	}
+
+	if 0 == len(events) {
+		return json.NewEncoder(w).Encode(trace)
+	}
+
+	origin, end := events[0].Time, events[0].Time
+	for _, event := range events {
+		if event.Time.Before(origin) {
+			origin = event.Time
+		}
+
+		if event.Time.After(end) {
+			end = event.Time
+		}
+	}
+
+	timestamp := func(at time.Time) float64 {
+		return float64(at.Sub(origin)) / float64(time.Microsecond)
+	}
+
+	lanes := make(map[uint64]*chromeTraceLane)
+	lane := func(id uint64) *chromeTraceLane {
+		if existingLane, exists := lanes[id]; exists {
+			return existingLane
+		}
+
+		lanes[id] = &chromeTraceLane{id: id, state: StatePending}
+
+		return lanes[id]
+	}
+
+	handlersStarts := make(map[uint64]TraceEvent)
+
+	for _, event := range events {
+		currentLane := lane(event.PromiseID)
+
+		if "" != event.Name {
+			currentLane.name = event.Name
+		}
+
+		switch event.Type {
+		case TraceEventCreated:
+			currentLane.createdAt = event.Time
+
+		case TraceEventSettled:
+			currentLane.settledAt = event.Time
+			currentLane.state = event.State
+
+		case TraceEventChained:
+			trace.TraceEvents = append(
+				trace.TraceEvents,
+				chromeTraceEvent{
+					Name:      string(event.Handler),
+					Category:  "chain",
+					Phase:     "s",
+					Timestamp: timestamp(event.Time),
+					ProcessID: chromeTraceProcessID,
+					ThreadID:  event.ParentID,
+					ID:        event.PromiseID,
+				},
+				chromeTraceEvent{
+					Name:      string(event.Handler),
+					Category:  "chain",
+					Phase:     "f",
+					Timestamp: timestamp(event.Time),
+					ProcessID: chromeTraceProcessID,
+					ThreadID:  event.PromiseID,
+					ID:        event.PromiseID,
+					Binding:   "e",
+				},
+			)
+
+		case TraceEventHandlerStarted:
+			handlersStarts[event.PromiseID] = event
+
+		case TraceEventHandlerFinished:
+			start, started := handlersStarts[event.PromiseID]
+			if !started {
+				continue
+			}
+
+			delete(handlersStarts, event.PromiseID)
+
+			duration := timestamp(event.Time) - timestamp(start.Time)
+
+			trace.TraceEvents = append(trace.TraceEvents, chromeTraceEvent{
+				Name:      string(event.Handler),
+				Category:  "handler",
+				Phase:     "X",
+				Timestamp: timestamp(start.Time),
+				Duration:  &duration,
+				ProcessID: chromeTraceProcessID,
+				ThreadID:  event.PromiseID,
+				Args: map[string]interface{}{
+					"parent": event.ParentID,
+				},
+			})
+		}
+	}
+
+	ids := make([]uint64, 0, len(lanes))
+	for id := range lanes {
+		ids = append(ids, id)
+	}
+
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i] < ids[j]
+	})
+
+	for _, id := range ids {
+		currentLane := lanes[id]
+
+		laneName := fmt.Sprintf("Promise #%d", currentLane.id)
+		if "" != currentLane.name {
+			laneName = fmt.Sprintf("%s %q", laneName, currentLane.name)
+		}
+
+		createdAt, settledAt := currentLane.createdAt, currentLane.settledAt
+		if createdAt.IsZero() {
+			createdAt = origin
+		}
+
+		if settledAt.IsZero() {
+			settledAt = end
+		}
+
+		duration := timestamp(settledAt) - timestamp(createdAt)
+
+		trace.TraceEvents = append(
+			trace.TraceEvents,
+			chromeTraceEvent{
+				Name:      "thread_name",
+				Phase:     "M",
+				ProcessID: chromeTraceProcessID,
+				ThreadID:  currentLane.id,
+				Args: map[string]interface{}{
+					"name": laneName,
+				},
+			},
+			chromeTraceEvent{
+				Name:      string(currentLane.state),
+				Category:  "promise",
+				Phase:     "X",
+				Timestamp: timestamp(createdAt),
+				Duration:  &duration,
+				ProcessID: chromeTraceProcessID,
+				ThreadID:  currentLane.id,
+				Args: map[string]interface{}{
+					"state": currentLane.state,
+				},
+			},
+		)
+	}
+
+	return json.NewEncoder(w).Encode(trace)
+}
@@ -0,0 +1,74 @@
+package promise
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteChromeTrace(t *testing.T) {
+	t.Run("Writes empty trace", func(t *testing.T) {
+		var output bytes.Buffer
+
+		require.NoError(t, WriteChromeTrace(&output, nil))
+		require.JSONEq(t, `{"traceEvents": [], "displayTimeUnit": "ms"}`, output.String())
+	})
+
+	t.Run("Writes promise lanes with nested handlers", func(t *testing.T) {
+		var output bytes.Buffer
+
+		origin := time.Now()
+		at := func(milliseconds int) time.Time {
+			return origin.Add(time.Duration(milliseconds) * time.Millisecond)
+		}
+
+		require.NoError(t, WriteChromeTrace(&output, []TraceEvent{
+			{Type: TraceEventCreated, Time: at(0), PromiseID: 1, Name: "fetch", State: StatePending},
+			{Type: TraceEventCreated, Time: at(1), PromiseID: 2, Name: "fetch.then", State: StateSettling},
+			{Type: TraceEventChained, Time: at(1), PromiseID: 2, ParentID: 1, Name: "fetch.then", Handler: HandlerThen},
+			{Type: TraceEventSettled, Time: at(10), PromiseID: 1, Name: "fetch", State: StateFulfilled},
+			{Type: TraceEventHandlerStarted, Time: at(11), PromiseID: 2, ParentID: 1, Handler: HandlerThen},
+			{Type: TraceEventHandlerFinished, Time: at(15), PromiseID: 2, ParentID: 1, Handler: HandlerThen},
+			{Type: TraceEventCreated, Time: at(16), PromiseID: 3, State: StatePending},
+			{Type: TraceEventSettled, Time: at(20), PromiseID: 2, State: StateRejected},
+		}))
+
+		require.JSONEq(t, `{
+			"displayTimeUnit": "ms",
+			"traceEvents": [
+				{"name": "then", "cat": "chain", "ph": "s", "ts": 1000, "pid": 1, "tid": 1, "id": 2},
+				{"name": "then", "cat": "chain", "ph": "f", "ts": 1000, "pid": 1, "tid": 2, "id": 2, "bp": "e"},
+				{"name": "then", "cat": "handler", "ph": "X", "ts": 11000, "dur": 4000, "pid": 1, "tid": 2, "args": {"parent": 1}},
+				{"name": "thread_name", "ph": "M", "ts": 0, "pid": 1, "tid": 1, "args": {"name": "Promise #1 \"fetch\""}},
+				{"name": "fulfilled", "cat": "promise", "ph": "X", "ts": 0, "dur": 10000, "pid": 1, "tid": 1, "args": {"state": "fulfilled"}},
+				{"name": "thread_name", "ph": "M", "ts": 0, "pid": 1, "tid": 2, "args": {"name": "Promise #2 \"fetch.then\""}},
+				{"name": "rejected", "cat": "promise", "ph": "X", "ts": 1000, "dur": 19000, "pid": 1, "tid": 2, "args": {"state": "rejected"}},
+				{"name": "thread_name", "ph": "M", "ts": 0, "pid": 1, "tid": 3, "args": {"name": "Promise #3"}},
+				{"name": "pending", "cat": "promise", "ph": "X", "ts": 16000, "dur": 4000, "pid": 1, "tid": 3, "args": {"state": "pending"}}
+			]
+		}`, output.String())
+	})
+
+	t.Run("Writes recorded trace", func(t *testing.T) {
+		var output bytes.Buffer
+		var trace struct {
+			TraceEvents []map[string]interface{} `json:"traceEvents"`
+		}
+
+		recorder := NewTraceRecorder()
+
+		SetTracer(recorder)
+		defer SetTracer(nil)
+
+		Resolve(5).Then(func(value interface{}) (interface{}, error) {
+			return value, nil
+		})
+
+		require.NoError(t, recorder.WriteChromeTrace(&output))
+		require.NoError(t, json.Unmarshal(output.Bytes(), &trace))
+		require.Len(t, trace.TraceEvents, 7)
+	})
+}
@@ -0,0 +1,272 @@
+package promise
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+const (
+	CircuitClosed   = CircuitState("closed")
+	CircuitOpen     = CircuitState("open")
+	CircuitHalfOpen = CircuitState("half_open")
+
+	defaultCircuitWindow           = 10 * time.Second
+	defaultCircuitFailureThreshold = 0.5
+	defaultCircuitOpenTimeout      = 30 * time.Second
+)
+
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+type CircuitState string
+
+type CircuitBreakerConfig struct {
+	Window           time.Duration
+	MinRequests      int
+	FailureThreshold float64
+	OpenTimeout      time.Duration
+	HalfOpenProbes   int
+	ProbeTimeout     time.Duration
+	IsFailure        func(reason error) bool
+	OnStateChange    func(from, to CircuitState)
+}
+
+type CircuitBreaker struct {
+	mutex sync.Mutex
+
+	rt     *Runtime
+	clock  Clock
+	config CircuitBreakerConfig
+
+	state          CircuitState
+	generation     uint64
+	outcomes       []circuitOutcome
+	openedAt       time.Time
+	probesInFlight int
+	probeSuccesses int
+	probedAt       time.Time
+}
+
+type circuitOutcome struct {
+	at        time.Time
+	isFailure bool
+}
+
+type circuitTransition struct {
+	from CircuitState
+	to   CircuitState
+}
+
+func NewCircuitBreaker(config CircuitBreakerConfig) *CircuitBreaker {
+	return defaultRuntime.NewCircuitBreaker(config)
+}
+
+func (rt *Runtime) NewCircuitBreaker(config CircuitBreakerConfig) *CircuitBreaker {
+	if 0 >= config.Window {
+		config.Window = defaultCircuitWindow
+	}
+
+	if 1 > config.MinRequests {
+		config.MinRequests = 1
+	}
+
+	if 0 >= config.FailureThreshold {
+		config.FailureThreshold = defaultCircuitFailureThreshold
+	}
+
+	if 0 >= config.OpenTimeout {
+		config.OpenTimeout = defaultCircuitOpenTimeout
+	}
+
+	if 1 > config.HalfOpenProbes {
+		config.HalfOpenProbes = 1
+	}
+
+	if 0 >= config.ProbeTimeout {
+		config.ProbeTimeout = config.OpenTimeout
+	}
+
+	return &CircuitBreaker{
+		rt:     rt,
+		clock:  rt.makeOptions(nil).clock,
+		config: config,
+		state:  CircuitClosed,
+	}
+}
+
+func (cb *CircuitBreaker) State() CircuitState {
+	cb.mutex.Lock()
+	transition := cb.refresh(cb.clock.Now())
+	state := cb.state
+	cb.mutex.Unlock()
+
+	cb.notify(transition)
+
+	return state
+}
+
+func (cb *CircuitBreaker) Execute(factory func() Promiser) *Promise {
+	cb.mutex.Lock()
+
+	now := cb.clock.Now()
+	transition := cb.refresh(now)
+
+	if CircuitOpen == cb.state || (CircuitHalfOpen == cb.state && cb.probesInFlight >= cb.config.HalfOpenProbes) {
+		cb.mutex.Unlock()
+		cb.notify(transition)
+
+		return cb.rt.Reject(ErrCircuitOpen)
+	}
+
+	isProbe := CircuitHalfOpen == cb.state
+	if isProbe {
+		cb.probesInFlight++
+		cb.probedAt = now
+	}
+
+	generation := cb.generation
+
+	cb.mutex.Unlock()
+	cb.notify(transition)
+
+	isCreated := false
+
+	defer func() {
+		if !isCreated {
+			cb.release(generation, isProbe)
+		}
+	}()
+
+	p := factory()
+	isCreated = true
+
+	p.ThenCatch(
+		func(value interface{}) (interface{}, error) {
+			cb.record(generation, isProbe, false)
+
+			return nil, nil
+		},
+		func(reason error) (interface{}, error) {
+			cb.record(generation, isProbe, nil == cb.config.IsFailure || cb.config.IsFailure(reason))
+
+			return nil, nil
+		},
+	)
+
+	return cb.rt.Resolve(p)
+}
+
+func (cb *CircuitBreaker) record(generation uint64, isProbe bool, isFailure bool) {
+	cb.mutex.Lock()
+
+	if generation != cb.generation {
+		cb.mutex.Unlock()
+
+		return
+	}
+
+	now := cb.clock.Now()
+
+	var transition circuitTransition
+
+	switch {
+	case isProbe && isFailure:
+		transition = cb.transition(CircuitOpen, now)
+
+	case isProbe:
+		cb.probesInFlight--
+		cb.probeSuccesses++
+		cb.probedAt = now
+
+		if cb.probeSuccesses >= cb.config.HalfOpenProbes {
+			transition = cb.transition(CircuitClosed, now)
+		}
+
+	default:
+		cb.outcomes = append(cb.outcomes, circuitOutcome{at: now, isFailure: isFailure})
+		cb.prune(now)
+
+		if isFailure && cb.isTripped() {
+			transition = cb.transition(CircuitOpen, now)
+		}
+	}
+
+	cb.mutex.Unlock()
+	cb.notify(transition)
+}
+
+func (cb *CircuitBreaker) release(generation uint64, isProbe bool) {
+	if !isProbe {
+		return
+	}
+
+	cb.mutex.Lock()
+
+	if generation == cb.generation {
+		cb.probesInFlight--
+	}
+
+	cb.mutex.Unlock()
+}
+
+func (cb *CircuitBreaker) refresh(now time.Time) circuitTransition {
+	if CircuitOpen == cb.state && !now.Before(cb.openedAt.Add(cb.config.OpenTimeout)) {
+		return cb.transition(CircuitHalfOpen, now)
+	}
+
+	if CircuitHalfOpen == cb.state && 0 != cb.probesInFlight && !now.Before(cb.probedAt.Add(cb.config.ProbeTimeout)) {
+		return cb.transition(CircuitOpen, now)
+	}
+
+	return circuitTransition{}
+}
+
+func (cb *CircuitBreaker) transition(to CircuitState, now time.Time) circuitTransition {
+	from := cb.state
+
+	cb.state = to
+	cb.generation++
+	cb.outcomes = nil
+	cb.probesInFlight = 0
+	cb.probeSuccesses = 0
+
+	if CircuitOpen == to {
+		cb.openedAt = now
+	}
+
+	return circuitTransition{from: from, to: to}
+}
+
+func (cb *CircuitBreaker) prune(now time.Time) {
+	windowStart := now.Add(-cb.config.Window)
+
+	i := 0
+	for i < len(cb.outcomes) && cb.outcomes[i].at.Before(windowStart) {
+		i++
+	}
+
+	cb.outcomes = cb.outcomes[i:]
+}
+
+func (cb *CircuitBreaker) isTripped() bool {
+	if len(cb.outcomes) < cb.config.MinRequests {
+		return false
+	}
+
+	failures := 0
+	for _, outcome := range cb.outcomes {
+		if outcome.isFailure {
+			failures++
+		}
+	}
+
+	return float64(failures)/float64(len(cb.outcomes)) >= cb.config.FailureThreshold
+}
+
+func (cb *CircuitBreaker) notify(transition circuitTransition) {
+	if "" == transition.to || nil == cb.config.OnStateChange {
+		return
+	}
+
+	cb.config.OnStateChange(transition.from, transition.to)
+}
@@ -0,0 +1,230 @@
+package promise
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jaswdr/faker"
+	"github.com/stretchr/testify/require"
+)
+
+type circuitTransitionRecorder struct {
+	transitions []circuitTransition
+}
+
+func (r *circuitTransitionRecorder) OnStateChange(from, to CircuitState) {
+	r.transitions = append(r.transitions, circuitTransition{from: from, to: to})
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	fakerInstance := faker.New()
+
+	newBreaker := func(config CircuitBreakerConfig) (*CircuitBreaker, *fakeClock, *circuitTransitionRecorder) {
+		clock := &fakeClock{now: time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)}
+		recorder := &circuitTransitionRecorder{}
+
+		config.OnStateChange = recorder.OnStateChange
+
+		return NewRuntime(WithClock(clock)).NewCircuitBreaker(config), clock, recorder
+	}
+
+	fail := func() Promiser {
+		return Reject(errors.New(fakerInstance.Lorem().Sentence(6)))
+	}
+
+	succeed := func() Promiser {
+		return Resolve(fakerInstance.Int())
+	}
+
+	t.Run("Passes results through while closed", func(t *testing.T) {
+		breaker, _, _ := newBreaker(CircuitBreakerConfig{})
+
+		value := fakerInstance.Int()
+		pending := Pending()
+
+		promise := breaker.Execute(func() Promiser {
+			return pending
+		})
+
+		require.Equal(t, StatePending, promise.State())
+
+		require.NoError(t, pending.Resolve(value))
+
+		require.Equal(t, StateFulfilled, promise.State())
+		require.Equal(t, value, promise.value)
+		require.Equal(t, CircuitClosed, breaker.State())
+	})
+
+	t.Run("Opens when failure ratio in the window reaches the threshold", func(t *testing.T) {
+		breaker, clock, recorder := newBreaker(CircuitBreakerConfig{
+			Window:           time.Minute,
+			MinRequests:      4,
+			FailureThreshold: 0.5,
+		})
+
+		breaker.Execute(fail)
+		clock.Advance(2 * time.Minute)
+
+		breaker.Execute(succeed)
+		breaker.Execute(succeed)
+		breaker.Execute(fail)
+
+		require.Equal(t, CircuitClosed, breaker.State())
+
+		breaker.Execute(fail)
+
+		require.Equal(t, CircuitOpen, breaker.State())
+		require.Equal(t, []circuitTransition{{from: CircuitClosed, to: CircuitOpen}}, recorder.transitions)
+
+		called := false
+		promise := breaker.Execute(func() Promiser {
+			called = true
+
+			return succeed()
+		})
+
+		require.False(t, called)
+		require.Equal(t, StateRejected, promise.State())
+		require.Same(t, ErrCircuitOpen, promise.err)
+	})
+
+	t.Run("Lets probes through when half-open", func(t *testing.T) {
+		breaker, clock, recorder := newBreaker(CircuitBreakerConfig{
+			OpenTimeout:    time.Minute,
+			HalfOpenProbes: 2,
+		})
+
+		breaker.Execute(fail)
+
+		require.Equal(t, CircuitOpen, breaker.State())
+
+		clock.Advance(time.Minute)
+
+		firstProbe, secondProbe := Pending(), Pending()
+
+		breaker.Execute(func() Promiser { return firstProbe })
+		breaker.Execute(func() Promiser { return secondProbe })
+
+		require.Equal(t, CircuitHalfOpen, breaker.State())
+		require.Same(t, ErrCircuitOpen, breaker.Execute(succeed).err)
+
+		require.NoError(t, firstProbe.Resolve(nil))
+		require.Equal(t, CircuitHalfOpen, breaker.State())
+
+		require.NoError(t, secondProbe.Resolve(nil))
+		require.Equal(t, CircuitClosed, breaker.State())
+
+		require.Equal(t, []circuitTransition{
+			{from: CircuitClosed, to: CircuitOpen},
+			{from: CircuitOpen, to: CircuitHalfOpen},
+			{from: CircuitHalfOpen, to: CircuitClosed},
+		}, recorder.transitions)
+	})
+
+	t.Run("Opens again when a probe fails", func(t *testing.T) {
+		breaker, clock, recorder := newBreaker(CircuitBreakerConfig{OpenTimeout: time.Minute})
+
+		breaker.Execute(fail)
+		clock.Advance(time.Minute)
+		breaker.Execute(fail)
+
+		require.Equal(t, CircuitOpen, breaker.State())
+		require.Equal(t, []circuitTransition{
+			{from: CircuitClosed, to: CircuitOpen},
+			{from: CircuitOpen, to: CircuitHalfOpen},
+			{from: CircuitHalfOpen, to: CircuitOpen},
+		}, recorder.transitions)
+
+		clock.Advance(59 * time.Second)
+
+		require.Equal(t, CircuitOpen, breaker.State())
+	})
+
+	t.Run("Ignores results of calls started in a previous state", func(t *testing.T) {
+		breaker, _, _ := newBreaker(CircuitBreakerConfig{})
+
+		pending := Pending()
+
+		breaker.Execute(func() Promiser { return pending })
+		breaker.Execute(fail)
+
+		require.Equal(t, CircuitOpen, breaker.State())
+
+		require.NoError(t, pending.Resolve(nil))
+
+		require.Equal(t, CircuitOpen, breaker.State())
+	})
+
+	t.Run("Counts only failures recognised by IsFailure", func(t *testing.T) {
+		breaker, _, _ := newBreaker(CircuitBreakerConfig{
+			IsFailure: func(reason error) bool {
+				return !errors.Is(reason, ErrCanceled)
+			},
+		})
+
+		breaker.Execute(func() Promiser {
+			return Reject(ErrCanceled)
+		})
+
+		require.Equal(t, CircuitClosed, breaker.State())
+
+		breaker.Execute(fail)
+
+		require.Equal(t, CircuitOpen, breaker.State())
+	})
+	t.Run("Releases the probe slot when the factory panics", func(t *testing.T) {
+		breaker, clock, _ := newBreaker(CircuitBreakerConfig{OpenTimeout: time.Minute})
+
+		breaker.Execute(fail)
+		clock.Advance(time.Minute)
+
+		require.PanicsWithValue(t, "boom", func() {
+			breaker.Execute(func() Promiser {
+				panic("boom")
+			})
+		})
+
+		require.Equal(t, CircuitHalfOpen, breaker.State())
+
+		breaker.Execute(succeed)
+
+		require.Equal(t, CircuitClosed, breaker.State())
+	})
+
+	t.Run("Opens again when probes do not settle before probe timeout", func(t *testing.T) {
+		breaker, clock, recorder := newBreaker(CircuitBreakerConfig{
+			OpenTimeout:  time.Minute,
+			ProbeTimeout: 10 * time.Second,
+		})
+
+		breaker.Execute(fail)
+		clock.Advance(time.Minute)
+
+		stuck := Pending()
+
+		breaker.Execute(func() Promiser { return stuck })
+
+		clock.Advance(9 * time.Second)
+
+		require.Equal(t, CircuitHalfOpen, breaker.State())
+		require.Same(t, ErrCircuitOpen, breaker.Execute(succeed).err)
+
+		clock.Advance(time.Second)
+
+		require.Equal(t, CircuitOpen, breaker.State())
+
+		require.NoError(t, stuck.Resolve(nil))
+		clock.Advance(time.Minute)
+		breaker.Execute(succeed)
+
+		require.Equal(t, CircuitClosed, breaker.State())
+		require.Equal(t, []circuitTransition{
+			{from: CircuitClosed, to: CircuitOpen},
+			{from: CircuitOpen, to: CircuitHalfOpen},
+			{from: CircuitHalfOpen, to: CircuitOpen},
+			{from: CircuitOpen, to: CircuitHalfOpen},
+			{from: CircuitHalfOpen, to: CircuitClosed},
+		}, recorder.transitions)
+	})
+}
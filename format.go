@@ -0,0 +1,136 @@
+package promise
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+)
+
+const packagePath = "github.com/donatorsky/go-promise"
+
+func (p *Promise) String() string {
+	p.mutex.RLock()
+	state, value, reason, name := p.state, p.value, p.err, p.name
+	p.mutex.RUnlock()
+
+	var description strings.Builder
+
+	description.WriteString("Promise{")
+	description.WriteString(string(state))
+
+	switch state {
+	case StateFulfilled:
+		_, _ = fmt.Fprintf(&description, ": %v", value)
+
+	case StateRejected:
+		_, _ = fmt.Fprintf(&description, ": %v", reason)
+	}
+
+	if "" != name {
+		_, _ = fmt.Fprintf(&description, ", name: %q", name)
+	}
+
+	description.WriteString("}")
+
+	return description.String()
+}
+
+func (p *Promise) GoString() string {
+	p.mutex.RLock()
+	state, value, reason := p.state, p.value, p.err
+	p.mutex.RUnlock()
+
+	switch state {
+	case StateFulfilled:
+		return fmt.Sprintf("promise.Resolve(%#v)", value)
+
+	case StateRejected:
+		return fmt.Sprintf("promise.Reject(%#v)", reason)
+
+	default:
+		return "promise.Pending()"
+	}
+}
+
+func (p *Promise) Format(f fmt.State, verb rune) {
+	switch verb {
+	case 'v':
+		if f.Flag('#') {
+			_, _ = fmt.Fprint(f, p.GoString())
+		} else if f.Flag('+') {
+			_, _ = fmt.Fprint(f, p.details())
+		} else {
+			_, _ = fmt.Fprint(f, p.String())
+		}
+
+	case 's':
+		_, _ = fmt.Fprint(f, p.String())
+
+	case 'q':
+		_, _ = fmt.Fprintf(f, "%q", p.String())
+
+	default:
+		_, _ = fmt.Fprintf(f, "%%!%c(*promise.Promise=%s)", verb, p.String())
+	}
+}
+
+func (p *Promise) CreationSite() string {
+	if 0 == len(p.stack) {
+		return ""
+	}
+
+	frames := runtime.CallersFrames(p.stack)
+
+	for {
+		frame, more := frames.Next()
+
+		if !isPackageFrame(frame) {
+			return fmt.Sprintf("%s:%d", frame.File, frame.Line)
+		}
+
+		if !more {
+			return ""
+		}
+	}
+}
+
+func (p *Promise) details() string {
+	p.mutex.RLock()
+	state, value, reason, name, handlers := p.state, p.value, p.err, p.name, len(p.handlers)
+	p.mutex.RUnlock()
+
+	var details strings.Builder
+
+	details.WriteString("Promise{")
+	details.WriteString(string(state))
+
+	switch state {
+	case StateFulfilled:
+		_, _ = fmt.Fprintf(&details, ": %+v", value)
+
+	case StateRejected:
+		_, _ = fmt.Fprintf(&details, ": %+v", reason)
+	}
+
+	if "" != name {
+		_, _ = fmt.Fprintf(&details, ", name: %q", name)
+	}
+
+	if !p.createdAt.IsZero() {
+		_, _ = fmt.Fprintf(&details, ", age: %s", p.now().Sub(p.createdAt))
+	}
+
+	_, _ = fmt.Fprintf(&details, ", handlers: %d", handlers)
+
+	if site := p.CreationSite(); "" != site {
+		_, _ = fmt.Fprintf(&details, ", created at: %s", site)
+	}
+
+	details.WriteString("}")
+
+	return details.String()
+}
+
+func isPackageFrame(frame runtime.Frame) bool {
+	return strings.HasPrefix(frame.Function, packagePath+".") && !strings.HasSuffix(frame.File, "_test.go")
+}
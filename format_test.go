@@ -0,0 +1,128 @@
+package promise
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/jaswdr/faker"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPromise_String(t *testing.T) {
+	fakerInstance := faker.New()
+
+	value := fakerInstance.Lorem().Word()
+	reason := errors.New(fakerInstance.Lorem().Sentence(6))
+
+	for _, tt := range []struct {
+		promise  *Promise
+		expected string
+	}{
+		{promise: Resolve(value), expected: fmt.Sprintf("Promise{fulfilled: %s}", value)},
+		{promise: Reject(reason), expected: fmt.Sprintf("Promise{rejected: %s}", reason)},
+		{promise: Pending(), expected: "Promise{pending}"},
+		{promise: &Promise{state: StateSettling}, expected: "Promise{settling}"},
+		{promise: Resolve(value, WithName("fetch")), expected: fmt.Sprintf("Promise{fulfilled: %s, name: \"fetch\"}", value)},
+		{promise: Pending().Named("fetch"), expected: "Promise{pending, name: \"fetch\"}"},
+	} {
+		t.Run(fmt.Sprintf("Describes Promise in state: %s", tt.promise.state), func(t *testing.T) {
+			require.Equal(t, tt.expected, tt.promise.String())
+			require.Equal(t, tt.expected, fmt.Sprintf("%v", tt.promise))
+			require.Equal(t, tt.expected, fmt.Sprintf("%s", tt.promise))
+			require.Equal(t, fmt.Sprintf("%q", tt.expected), fmt.Sprintf("%q", tt.promise))
+		})
+	}
+
+	t.Run("Can be used inside handlers of the same Promise", func(t *testing.T) {
+		callsStack := newCallsRegistry(1)
+
+		promise := Resolve(value)
+
+		promise.Then(func(_ interface{}) (interface{}, error) {
+			require.Equal(t, fmt.Sprintf("Promise{fulfilled: %s}", value), promise.String())
+
+			callsStack.Register("Then")
+
+			return nil, nil
+		})
+
+		callsStack.AssertCompletedInOrder(t, []string{"Then"})
+	})
+}
+
+func TestPromise_GoString(t *testing.T) {
+	for _, tt := range []struct {
+		promise  *Promise
+		expected string
+	}{
+		{promise: Resolve(5), expected: "promise.Resolve(5)"},
+		{promise: Resolve("foo"), expected: `promise.Resolve("foo")`},
+		{promise: Reject(errors.New("nope")), expected: `promise.Reject(&errors.errorString{s:"nope"})`},
+		{promise: Pending(), expected: "promise.Pending()"},
+	} {
+		t.Run(fmt.Sprintf("Describes Promise in state: %s", tt.promise.state), func(t *testing.T) {
+			require.Equal(t, tt.expected, tt.promise.GoString())
+			require.Equal(t, tt.expected, fmt.Sprintf("%#v", tt.promise))
+		})
+	}
+}
+
+func TestPromise_Format(t *testing.T) {
+	fakerInstance := faker.New()
+
+	t.Run("Plus flag adds diagnostic details", func(t *testing.T) {
+		name := fakerInstance.Lorem().Word()
+		promise := Pending(WithCreationSite()).Named(name)
+		promise.Finally(func() {})
+
+		require.Regexp(
+			t,
+			regexp.MustCompile(fmt.Sprintf(`^Promise\{pending, name: "%s", age: [^,]+, handlers: 1, created at: .+/format_test\.go:\d+\}$`, name)),
+			fmt.Sprintf("%+v", promise),
+		)
+	})
+
+	t.Run("Plus flag includes settlement outcome", func(t *testing.T) {
+		require.Regexp(t, `^Promise\{fulfilled: 5, age: [^,]+, handlers: 0, created at: .+\}$`, fmt.Sprintf("%+v", Resolve(5, WithCreationSite())))
+		require.Regexp(t, `^Promise\{rejected: nope, age: [^,]+, handlers: 0, created at: .+\}$`, fmt.Sprintf("%+v", Reject(errors.New("nope"), WithCreationSite())))
+	})
+
+	t.Run("Plus flag omits creation site when it was not captured", func(t *testing.T) {
+		require.Regexp(t, `^Promise\{fulfilled: 5, age: [^,]+, handlers: 0\}$`, fmt.Sprintf("%+v", Resolve(5)))
+	})
+
+	t.Run("Unsupported verbs are reported", func(t *testing.T) {
+		require.Equal(t, "%!d(*promise.Promise=Promise{pending})", fmt.Sprintf("%d", Pending()))
+	})
+}
+
+func TestPromise_CreationSite(t *testing.T) {
+	t.Run("Points at the caller outside the package", func(t *testing.T) {
+		require.Regexp(t, `/format_test\.go:\d+$`, Pending(WithCreationSite()).CreationSite())
+		require.Regexp(t, `/format_test\.go:\d+$`, Pending(WithCreationSite()).Then(func(value interface{}) (interface{}, error) {
+			return value, nil
+		}).(*Promise).CreationSite())
+	})
+
+	t.Run("Points at the caller while tracking", func(t *testing.T) {
+		tracker := StartTracking()
+		defer tracker.Stop()
+
+		require.Regexp(t, `/format_test\.go:\d+$`, Pending().CreationSite())
+	})
+
+	t.Run("Is empty when not captured", func(t *testing.T) {
+		require.Empty(t, Pending().CreationSite())
+		require.Empty(t, Pending().Then(func(value interface{}) (interface{}, error) {
+			return value, nil
+		}).(*Promise).CreationSite())
+	})
+
+	t.Run("Is empty for Promise created without constructor", func(t *testing.T) {
+		promise := Promise{}
+
+		require.Empty(t, promise.CreationSite())
+	})
+}
@@ -0,0 +1,156 @@
+package promise
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+const (
+	EdgeAdopted = HandlerKind("adopted")
+
+	maxGraphChildren = 256
+)
+
+type GraphNode struct {
+	ID      uint64
+	Name    string
+	State   State
+	Promise *Promise
+}
+
+type GraphEdge struct {
+	From uint64
+	To   uint64
+	Kind HandlerKind
+}
+
+type Graph struct {
+	Nodes     []GraphNode
+	Edges     []GraphEdge
+	Truncated bool
+}
+
+func (p *Promise) Graph() Graph {
+	var graph Graph
+
+	visited := map[*Promise]bool{p: true}
+	queue := []*Promise{p}
+
+	visit := func(from *Promise, to *Promise, kind HandlerKind) {
+		if EdgeAdopted == kind {
+			graph.Edges = append(graph.Edges, GraphEdge{From: to.id, To: from.id, Kind: kind})
+		} else {
+			graph.Edges = append(graph.Edges, GraphEdge{From: from.id, To: to.id, Kind: kind})
+		}
+
+		if !visited[to] {
+			visited[to] = true
+			queue = append(queue, to)
+		}
+	}
+
+	for 0 != len(queue) {
+		current := queue[0]
+		queue = queue[1:]
+
+		current.mutex.RLock()
+		node := GraphNode{
+			ID:      current.id,
+			Name:    current.name,
+			State:   current.state,
+			Promise: current,
+		}
+		follows := current.follows
+		children := make([]chainLink, len(current.children))
+		copy(children, current.children)
+		droppedChildren := current.droppedChildren
+		current.mutex.RUnlock()
+
+		if droppedChildren {
+			graph.Truncated = true
+		}
+
+		graph.Nodes = append(graph.Nodes, node)
+
+		if nil != follows {
+			visit(current, follows, EdgeAdopted)
+		}
+
+		for _, child := range children {
+			visit(current, child.promise, child.kind)
+		}
+	}
+
+	return graph
+}
+
+func (g Graph) WriteDOT(w io.Writer) error {
+	_, err := io.WriteString(w, g.DOT())
+
+	return err
+}
+
+func (p *Promise) recordChild(child *Promise, kind HandlerKind) {
+	if len(p.children) >= maxGraphChildren {
+		p.droppedChildren = true
+
+		return
+	}
+
+	p.children = append(p.children, chainLink{promise: child, kind: kind})
+}
+
+func (g Graph) DOT() string {
+	var dot strings.Builder
+
+	dot.WriteString("digraph promises {\n")
+	dot.WriteString("\tnode [shape=box, style=\"rounded,filled\"];\n")
+
+	for _, node := range g.Nodes {
+		label := fmt.Sprintf("#%d", node.ID)
+
+		if nil != node.Promise {
+			label = fmt.Sprintf("%s\n%s", label, node.Promise.String())
+		} else if "" != node.Name {
+			label = fmt.Sprintf("%s %s\n%s", label, node.Name, node.State)
+		} else {
+			label = fmt.Sprintf("%s\n%s", label, node.State)
+		}
+
+		_, _ = fmt.Fprintf(&dot, "\tp%d [label=%s, fillcolor=%q];\n", node.ID, dotQuote(label), dotColor(node.State))
+	}
+
+	for _, edge := range g.Edges {
+		style := ""
+		if EdgeAdopted == edge.Kind {
+			style = ", style=dashed"
+		}
+
+		_, _ = fmt.Fprintf(&dot, "\tp%d -> p%d [label=%q%s];\n", edge.From, edge.To, string(edge.Kind), style)
+	}
+
+	dot.WriteString("}\n")
+
+	return dot.String()
+}
+
+func dotColor(state State) string {
+	switch state {
+	case StateFulfilled:
+		return "palegreen"
+
+	case StateRejected:
+		return "lightcoral"
+
+	case StateSettling:
+		return "lightblue"
+
+	default:
+		return "lightgoldenrod"
+	}
+}
+
+func dotQuote(label string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(label) + `"`
+}
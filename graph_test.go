@@ -0,0 +1,169 @@
+package promise
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/jaswdr/faker"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPromise_Graph(t *testing.T) {
+	fakerInstance := faker.New()
+
+	value := fakerInstance.Lorem().Word()
+
+	t.Run("Contains only the root when nothing is chained", func(t *testing.T) {
+		promise := Resolve(value)
+
+		graph := promise.Graph()
+
+		require.Equal(t, []GraphNode{{ID: promise.ID(), State: StateFulfilled, Promise: promise}}, graph.Nodes)
+		require.Empty(t, graph.Edges)
+	})
+
+	t.Run("Walks derived and adopted promises", func(t *testing.T) {
+		callsStack := newCallsRegistry(1)
+
+		root := Pending(WithChainGraph()).Named("root")
+		inner := Resolve(value)
+
+		thenPromise := root.Then(func(_ interface{}) (interface{}, error) {
+			return inner, nil
+		}).(*Promise)
+		catchPromise := thenPromise.Catch(func(reason error) {}).(*Promise)
+		finallyPromise := catchPromise.Finally(func() {
+			callsStack.Register("Finally")
+		}).(*Promise)
+
+		require.NoError(t, root.Resolve(value))
+
+		callsStack.AssertCompletedInOrder(t, []string{"Finally"})
+
+		graph := root.Graph()
+
+		require.Equal(t, []GraphNode{
+			{ID: root.ID(), Name: "root", State: StateFulfilled, Promise: root},
+			{ID: thenPromise.ID(), Name: "root.then", State: StateFulfilled, Promise: thenPromise},
+			{ID: inner.ID(), State: StateFulfilled, Promise: inner},
+			{ID: catchPromise.ID(), Name: "root.then.catch", State: StateFulfilled, Promise: catchPromise},
+			{ID: finallyPromise.ID(), Name: "root.then.catch.finally", State: StateFulfilled, Promise: finallyPromise},
+		}, graph.Nodes)
+
+		require.Equal(t, []GraphEdge{
+			{From: root.ID(), To: thenPromise.ID(), Kind: HandlerThen},
+			{From: inner.ID(), To: thenPromise.ID(), Kind: EdgeAdopted},
+			{From: thenPromise.ID(), To: catchPromise.ID(), Kind: HandlerCatch},
+			{From: catchPromise.ID(), To: finallyPromise.ID(), Kind: HandlerFinally},
+		}, graph.Edges)
+	})
+
+	t.Run("Includes promises chained from several branches once", func(t *testing.T) {
+		root := Pending(WithChainGraph())
+
+		first := root.Then(func(value interface{}) (interface{}, error) {
+			return value, nil
+		}).(*Promise)
+		second := root.Catch(func(reason error) {}).(*Promise)
+
+		graph := root.Graph()
+
+		require.Len(t, graph.Nodes, 3)
+		require.Equal(t, []GraphEdge{
+			{From: root.ID(), To: first.ID(), Kind: HandlerThen},
+			{From: root.ID(), To: second.ID(), Kind: HandlerCatch},
+		}, graph.Edges)
+	})
+
+	t.Run("Walks derived promises of runtimes recording chain graphs", func(t *testing.T) {
+		root := NewRuntime(WithChainGraph()).Pending()
+		derived := root.Then(func(value interface{}) (interface{}, error) {
+			return value, nil
+		}).(*Promise)
+
+		require.Equal(t, []GraphEdge{
+			{From: root.ID(), To: derived.ID(), Kind: HandlerThen},
+		}, root.Graph().Edges)
+	})
+
+	t.Run("Does not retain derived promises without the option", func(t *testing.T) {
+		tracker := StartTracking()
+		defer tracker.Stop()
+
+		root := Pending(WithTracer(NewTraceRecorder()))
+
+		for i := 0; i < 10; i++ {
+			root.Then(func(value interface{}) (interface{}, error) {
+				return value, nil
+			})
+		}
+
+		require.Empty(t, root.children)
+		require.Empty(t, root.Graph().Edges)
+	})
+
+	t.Run("Retains a limited number of derived promises", func(t *testing.T) {
+		root := Resolve(value, WithChainGraph())
+
+		for i := 0; i <= maxGraphChildren; i++ {
+			root.Then(func(value interface{}) (interface{}, error) {
+				return value, nil
+			})
+		}
+
+		graph := root.Graph()
+
+		require.Len(t, root.children, maxGraphChildren)
+		require.Len(t, graph.Edges, maxGraphChildren)
+		require.True(t, graph.Truncated)
+	})
+}
+
+func TestGraph_DOT(t *testing.T) {
+	root := Pending().Named(`say "hi"`)
+	fulfilled := root.Then(func(value interface{}) (interface{}, error) {
+		return value, nil
+	}).(*Promise)
+	rejected := root.Catch(func(reason error) {}).(*Promise)
+
+	graph := Graph{
+		Nodes: []GraphNode{
+			{ID: root.ID(), Name: root.Name(), State: StatePending},
+			{ID: fulfilled.ID(), State: StateFulfilled},
+			{ID: rejected.ID(), State: StateRejected},
+			{ID: 100, State: StateSettling},
+		},
+		Edges: []GraphEdge{
+			{From: root.ID(), To: fulfilled.ID(), Kind: HandlerThen},
+			{From: root.ID(), To: rejected.ID(), Kind: HandlerCatch},
+			{From: 100, To: fulfilled.ID(), Kind: EdgeAdopted},
+		},
+	}
+
+	expected := fmt.Sprintf(`digraph promises {
+	node [shape=box, style="rounded,filled"];
+	p%[1]d [label="#%[1]d say \"hi\"\npending", fillcolor="lightgoldenrod"];
+	p%[2]d [label="#%[2]d\nfulfilled", fillcolor="palegreen"];
+	p%[3]d [label="#%[3]d\nrejected", fillcolor="lightcoral"];
+	p100 [label="#100\nsettling", fillcolor="lightblue"];
+	p%[1]d -> p%[2]d [label="then"];
+	p%[1]d -> p%[3]d [label="catch"];
+	p100 -> p%[2]d [label="adopted", style=dashed];
+}
+`, root.ID(), fulfilled.ID(), rejected.ID())
+
+	require.Equal(t, expected, graph.DOT())
+
+	var buffer bytes.Buffer
+
+	require.NoError(t, graph.WriteDOT(&buffer))
+	require.Equal(t, expected, buffer.String())
+
+	t.Run("Describes settled promises with their results", func(t *testing.T) {
+		promise := Reject(errors.New("nope"))
+
+		require.Contains(t, promise.Graph().DOT(), fmt.Sprintf(`p%[1]d [label="#%[1]d\nPromise{rejected: nope}", fillcolor="lightcoral"];`, promise.ID()))
+	})
+}
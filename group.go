@@ -0,0 +1,200 @@
+package promise
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+const (
+	GroupFailFast   = GroupPolicy("fail_fast")
+	GroupCollectAll = GroupPolicy("collect_all")
+	GroupBestEffort = GroupPolicy("best_effort")
+)
+
+type GroupPolicy string
+
+type AggregateError struct {
+	Errors []error
+}
+
+func (e *AggregateError) Error() string {
+	messages := make([]string, 0, len(e.Errors))
+	for _, err := range e.Errors {
+		messages = append(messages, err.Error())
+	}
+
+	return fmt.Sprintf("%d errors occurred: %s", len(e.Errors), strings.Join(messages, "; "))
+}
+
+func (e *AggregateError) Is(target error) bool {
+	for _, err := range e.Errors {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (e *AggregateError) As(target interface{}) bool {
+	for _, err := range e.Errors {
+		if errors.As(err, target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+type canceler interface {
+	Cancel() bool
+}
+
+func cancelPromiser(p Promiser) {
+	if cancelable, ok := p.(canceler); ok {
+		cancelable.Cancel()
+	}
+}
+
+type groupMember struct {
+	promise Promiser
+	state   State
+	value   interface{}
+	err     error
+}
+
+type Group struct {
+	mutex sync.Mutex
+	rt    *Runtime
+
+	policy  GroupPolicy
+	members []*groupMember
+	pending int
+	failure error
+	waiters []*Promise
+}
+
+func NewGroup(policy GroupPolicy) *Group {
+	return defaultRuntime.NewGroup(policy)
+}
+
+func (rt *Runtime) NewGroup(policy GroupPolicy) *Group {
+	return &Group{rt: rt, policy: policy}
+}
+
+func (g *Group) Add(p Promiser) {
+	member := &groupMember{promise: p, state: StatePending}
+
+	g.mutex.Lock()
+	g.members = append(g.members, member)
+	g.pending++
+	g.mutex.Unlock()
+
+	p.ThenCatch(
+		func(value interface{}) (interface{}, error) {
+			g.settleMember(member, StateFulfilled, value, nil)
+
+			return nil, nil
+		},
+		func(reason error) (interface{}, error) {
+			g.settleMember(member, StateRejected, nil, reason)
+
+			return nil, nil
+		},
+	)
+}
+
+func (g *Group) Wait() *Promise {
+	waiter := g.rt.Pending()
+
+	g.mutex.Lock()
+	g.waiters = append(g.waiters, waiter)
+	g.mutex.Unlock()
+
+	g.release()
+
+	return waiter
+}
+
+func (g *Group) Cancel() {
+	g.mutex.Lock()
+	members := make([]*groupMember, len(g.members))
+	copy(members, g.members)
+	g.mutex.Unlock()
+
+	for _, member := range members {
+		cancelPromiser(member.promise)
+	}
+}
+
+func (g *Group) settleMember(member *groupMember, state State, value interface{}, reason error) {
+	g.mutex.Lock()
+	member.state = state
+	member.value = value
+	member.err = reason
+	g.pending--
+
+	shouldCancel := StateRejected == state && GroupFailFast == g.policy && nil == g.failure
+	if shouldCancel {
+		g.failure = reason
+	}
+	g.mutex.Unlock()
+
+	if shouldCancel {
+		g.Cancel()
+	}
+
+	g.release()
+}
+
+func (g *Group) release() {
+	g.mutex.Lock()
+
+	if 0 == len(g.waiters) || (0 != g.pending && nil == g.failure) {
+		g.mutex.Unlock()
+
+		return
+	}
+
+	waiters := g.waiters
+	g.waiters = nil
+	values, err := g.result()
+
+	g.mutex.Unlock()
+
+	for _, waiter := range waiters {
+		if nil != err {
+			_ = waiter.Reject(err)
+		} else {
+			_ = waiter.Resolve(values)
+		}
+	}
+}
+
+func (g *Group) result() ([]interface{}, error) {
+	if nil != g.failure {
+		return nil, g.failure
+	}
+
+	values := make([]interface{}, 0, len(g.members))
+
+	var errs []error
+
+	for _, member := range g.members {
+		if StateRejected == member.state {
+			errs = append(errs, member.err)
+
+			continue
+		}
+
+		values = append(values, member.value)
+	}
+
+	if 0 != len(errs) && GroupBestEffort != g.policy {
+		return nil, &AggregateError{Errors: errs}
+	}
+
+	return values, nil
+}
@@ -0,0 +1,246 @@
+package promise
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jaswdr/faker"
+	"github.com/stretchr/testify/require"
+)
+
+type customGroupError struct {
+	code int
+}
+
+func (e *customGroupError) Error() string {
+	return "custom group error"
+}
+
+func TestPromise_Cancel(t *testing.T) {
+	fakerInstance := faker.New()
+
+	t.Run("Rejects pending promise", func(t *testing.T) {
+		promise := Pending().Named("fetch")
+
+		require.True(t, promise.Cancel())
+		require.Equal(t, StateRejected, promise.State())
+		require.ErrorIs(t, promise.err, ErrCanceled)
+		require.EqualError(t, promise.err, `promise "fetch": promise canceled`)
+		require.False(t, promise.Cancel())
+	})
+
+	t.Run("Does not affect settled promise", func(t *testing.T) {
+		value := fakerInstance.Int()
+		promise := Resolve(value)
+
+		require.False(t, promise.Cancel())
+		require.Equal(t, StateFulfilled, promise.State())
+		require.Equal(t, value, promise.value)
+	})
+
+	t.Run("Notifies handlers while executor is still running", func(t *testing.T) {
+		callsStack := newCallsRegistry(1)
+		release := make(chan struct{})
+		defer close(release)
+
+		promise := NewPromise(func(resolve Resolver, _ Rejector) {
+			<-release
+
+			resolve(nil)
+		})
+
+		promise.Catch(func(reason error) {
+			require.ErrorIs(t, reason, ErrCanceled)
+
+			callsStack.Register("Catch")
+		})
+
+		require.True(t, promise.Cancel())
+
+		callsStack.AssertCompletedInOrder(t, []string{"Catch"})
+	})
+
+	t.Run("Keeps derived promise rejected when parent is fulfilled", func(t *testing.T) {
+		parent := Pending()
+		derived := parent.Then(func(value interface{}) (interface{}, error) {
+			return value, nil
+		}).(*Promise)
+
+		require.True(t, derived.Cancel())
+		require.NoError(t, parent.Resolve(fakerInstance.Int()))
+
+		require.Equal(t, StateRejected, derived.State())
+		require.ErrorIs(t, derived.err, ErrCanceled)
+	})
+
+	t.Run("Keeps derived promise rejected when parent is rejected", func(t *testing.T) {
+		rt := NewRuntime(WithUnhandledRejectionHandler(func(_ *Promise, _ error) {}))
+
+		parent := rt.Pending()
+		derived := parent.Then(func(value interface{}) (interface{}, error) {
+			return value, nil
+		}).(*Promise)
+
+		require.True(t, derived.Cancel())
+		require.NotPanics(t, func() {
+			require.NoError(t, parent.Reject(errors.New(fakerInstance.Lorem().Sentence(6))))
+		})
+
+		require.Equal(t, StateRejected, derived.State())
+		require.ErrorIs(t, derived.err, ErrCanceled)
+	})
+
+	t.Run("Keeps awaiting derived promise rejected when parent is settled", func(t *testing.T) {
+		parent := Pending()
+		derived := parent.FinallyAsync(func() Promiser {
+			return Resolve(nil)
+		}).(*Promise)
+
+		require.True(t, derived.Cancel())
+		require.NoError(t, parent.Resolve(fakerInstance.Int()))
+
+		require.Equal(t, StateRejected, derived.State())
+		require.ErrorIs(t, derived.err, ErrCanceled)
+	})
+}
+
+func TestGroup(t *testing.T) {
+	fakerInstance := faker.New()
+
+	t.Run("Fulfills with values of all members", func(t *testing.T) {
+		for _, policy := range []GroupPolicy{GroupFailFast, GroupCollectAll, GroupBestEffort} {
+			group := NewGroup(policy)
+
+			first, second := Pending(), Pending()
+
+			group.Add(first)
+			group.Add(Resolve("resolved"))
+
+			result := group.Wait()
+
+			group.Add(second)
+
+			require.NoError(t, first.Resolve(1))
+			require.Equal(t, StatePending, result.State())
+
+			require.NoError(t, second.Resolve(2))
+			require.Equal(t, StateFulfilled, result.State())
+			require.Equal(t, []interface{}{1, "resolved", 2}, result.value)
+		}
+	})
+
+	t.Run("Fulfills immediately without members", func(t *testing.T) {
+		result := NewGroup(GroupCollectAll).Wait()
+
+		require.Equal(t, StateFulfilled, result.State())
+		require.Equal(t, []interface{}{}, result.value)
+	})
+
+	t.Run("Fail-fast rejects on the first rejection and cancels the rest", func(t *testing.T) {
+		reason := errors.New(fakerInstance.Lorem().Sentence(6))
+		group := NewGroup(GroupFailFast)
+
+		first, second := Pending(), Pending()
+
+		group.Add(first)
+		group.Add(second)
+
+		result := group.Wait()
+
+		require.NoError(t, first.Reject(reason))
+
+		require.Equal(t, StateRejected, result.State())
+		require.Same(t, reason, result.err)
+		require.Equal(t, StateRejected, second.State())
+		require.ErrorIs(t, second.err, ErrCanceled)
+
+		require.Same(t, reason, group.Wait().err)
+	})
+
+	t.Run("Collect-all rejects with all reasons", func(t *testing.T) {
+		firstReason := errors.New(fakerInstance.Lorem().Sentence(6))
+		secondReason := &customGroupError{code: fakerInstance.Int()}
+		group := NewGroup(GroupCollectAll)
+
+		first, second, third := Pending(), Pending(), Pending()
+
+		group.Add(first)
+		group.Add(second)
+		group.Add(third)
+
+		result := group.Wait()
+
+		require.NoError(t, first.Reject(firstReason))
+		require.NoError(t, third.Resolve(fakerInstance.Int()))
+		require.Equal(t, StatePending, result.State())
+
+		require.NoError(t, second.Reject(secondReason))
+		require.Equal(t, StateRejected, result.State())
+
+		var aggregateErr *AggregateError
+
+		require.True(t, errors.As(result.err, &aggregateErr))
+		require.Equal(t, []error{firstReason, secondReason}, aggregateErr.Errors)
+		require.EqualError(t, aggregateErr, "2 errors occurred: "+firstReason.Error()+"; custom group error")
+		require.ErrorIs(t, result.err, firstReason)
+		require.NotErrorIs(t, result.err, ErrCanceled)
+
+		var customErr *customGroupError
+
+		require.True(t, errors.As(result.err, &customErr))
+		require.Same(t, secondReason, customErr)
+	})
+
+	t.Run("Best-effort fulfills with values of fulfilled members", func(t *testing.T) {
+		group := NewGroup(GroupBestEffort)
+
+		group.Add(Resolve(1))
+		group.Add(Reject(errors.New(fakerInstance.Lorem().Sentence(6))))
+		group.Add(Resolve(3))
+
+		result := group.Wait()
+
+		require.Equal(t, StateFulfilled, result.State())
+		require.Equal(t, []interface{}{1, 3}, result.value)
+	})
+
+	t.Run("Cancels pending members", func(t *testing.T) {
+		group := NewGroup(GroupCollectAll)
+
+		settled := Resolve(fakerInstance.Int())
+		pending := Pending()
+
+		group.Add(settled)
+		group.Add(pending)
+
+		result := group.Wait()
+
+		group.Cancel()
+
+		require.Equal(t, StateFulfilled, settled.State())
+		require.Equal(t, StateRejected, pending.State())
+		require.Equal(t, StateRejected, result.State())
+		require.ErrorIs(t, result.err, ErrCanceled)
+	})
+
+	t.Run("Cancels members derived with Then", func(t *testing.T) {
+		group := NewGroup(GroupCollectAll)
+
+		parent := Pending()
+		member := parent.Then(func(value interface{}) (interface{}, error) {
+			return value, nil
+		}).(*Promise)
+
+		group.Add(member)
+
+		result := group.Wait()
+
+		group.Cancel()
+
+		require.NoError(t, parent.Resolve(fakerInstance.Int()))
+
+		require.Equal(t, StateRejected, member.State())
+		require.Equal(t, StateRejected, result.State())
+		require.ErrorIs(t, result.err, ErrCanceled)
+	})
+}
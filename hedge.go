@@ -0,0 +1,145 @@
+package promise
+
+import (
+	"sync"
+	"time"
+)
+
+func Hedge(factory func(attempt int) Promiser, delay time.Duration, maxAttempts int) *Promise {
+	return defaultRuntime.Hedge(factory, delay, maxAttempts)
+}
+
+func (rt *Runtime) Hedge(factory func(attempt int) Promiser, delay time.Duration, maxAttempts int) *Promise {
+	if 1 > maxAttempts {
+		maxAttempts = 1
+	}
+
+	h := &hedge{
+		factory:     factory,
+		delay:       delay,
+		maxAttempts: maxAttempts,
+		result:      rt.Pending(),
+	}
+
+	h.result.onSettled(h.stop)
+	h.start()
+
+	return h.result
+}
+
+type hedge struct {
+	mutex sync.Mutex
+
+	factory     func(attempt int) Promiser
+	delay       time.Duration
+	maxAttempts int
+	result      *Promise
+
+	started  int
+	pending  int
+	attempts []Promiser
+	reasons  []error
+	timer    *time.Timer
+	isDone   bool
+}
+
+func (h *hedge) start() {
+	h.mutex.Lock()
+
+	if h.isDone || h.started == h.maxAttempts {
+		h.mutex.Unlock()
+
+		return
+	}
+
+	h.started++
+	h.pending++
+	attempt := h.started
+
+	if nil != h.timer {
+		h.timer.Stop()
+	}
+
+	if attempt < h.maxAttempts {
+		h.timer = time.AfterFunc(h.delay, h.start)
+	}
+
+	h.mutex.Unlock()
+
+	p := h.factory(attempt)
+
+	h.mutex.Lock()
+	h.attempts = append(h.attempts, p)
+	isDone := h.isDone
+	h.mutex.Unlock()
+
+	if isDone {
+		cancelPromiser(p)
+
+		return
+	}
+
+	p.ThenCatch(
+		func(value interface{}) (interface{}, error) {
+			_ = h.result.Resolve(value)
+
+			return nil, nil
+		},
+		func(reason error) (interface{}, error) {
+			h.fail(reason)
+
+			return nil, nil
+		},
+	)
+}
+
+func (h *hedge) fail(reason error) {
+	h.mutex.Lock()
+
+	h.pending--
+	h.reasons = append(h.reasons, reason)
+
+	if h.isDone {
+		h.mutex.Unlock()
+
+		return
+	}
+
+	if h.started < h.maxAttempts {
+		h.mutex.Unlock()
+
+		h.start()
+
+		return
+	}
+
+	if 0 != h.pending {
+		h.mutex.Unlock()
+
+		return
+	}
+
+	reasons := append([]error(nil), h.reasons...)
+
+	h.mutex.Unlock()
+
+	_ = h.result.Reject(&AggregateError{Errors: reasons})
+}
+
+func (h *hedge) stop() {
+	h.mutex.Lock()
+
+	h.isDone = true
+
+	if nil != h.timer {
+		h.timer.Stop()
+	}
+
+	attempts := append([]Promiser(nil), h.attempts...)
+
+	h.mutex.Unlock()
+
+	for _, attempt := range attempts {
+		cancelPromiser(attempt)
+	}
+}
@@ -0,0 +1,144 @@
+package promise
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jaswdr/faker"
+	"github.com/stretchr/testify/require"
+)
+
+type hedgeAttempts struct {
+	mutex    sync.Mutex
+	attempts []int
+	promises []*Promise
+}
+
+func (a *hedgeAttempts) factory(create func(attempt int) *Promise) func(attempt int) Promiser {
+	return func(attempt int) Promiser {
+		p := create(attempt)
+
+		a.mutex.Lock()
+		a.attempts = append(a.attempts, attempt)
+		a.promises = append(a.promises, p)
+		a.mutex.Unlock()
+
+		return p
+	}
+}
+
+func (a *hedgeAttempts) Attempts() []int {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	return append([]int(nil), a.attempts...)
+}
+
+func (a *hedgeAttempts) Promise(i int) *Promise {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	return a.promises[i]
+}
+
+func TestHedge(t *testing.T) {
+	fakerInstance := faker.New()
+
+	t.Run("Starts another attempt after delay and cancels the loser", func(t *testing.T) {
+		callsStack := newCallsRegistry(1)
+		attempts := &hedgeAttempts{}
+		value := fakerInstance.Int()
+
+		promise := Hedge(attempts.factory(func(attempt int) *Promise {
+			if 1 == attempt {
+				return Pending()
+			}
+
+			return Resolve(value)
+		}), 20*time.Millisecond, 3)
+
+		promise.Then(func(value interface{}) (interface{}, error) {
+			callsStack.Register("Then")
+
+			return value, nil
+		})
+
+		callsStack.AssertCompletedInOrderBefore(t, []string{"Then"}, time.Second)
+
+		require.Equal(t, value, promise.value)
+		require.Equal(t, []int{1, 2}, attempts.Attempts())
+		require.Equal(t, StateRejected, attempts.Promise(0).State())
+		require.ErrorIs(t, attempts.Promise(0).err, ErrCanceled)
+
+		time.Sleep(50 * time.Millisecond)
+
+		require.Equal(t, []int{1, 2}, attempts.Attempts())
+	})
+
+	t.Run("Does not start more attempts when the first one settles in time", func(t *testing.T) {
+		attempts := &hedgeAttempts{}
+		value := fakerInstance.Int()
+
+		promise := Hedge(attempts.factory(func(_ int) *Promise {
+			return Resolve(value)
+		}), 10*time.Millisecond, 3)
+
+		time.Sleep(50 * time.Millisecond)
+
+		require.Equal(t, StateFulfilled, promise.State())
+		require.Equal(t, value, promise.value)
+		require.Equal(t, []int{1}, attempts.Attempts())
+	})
+
+	t.Run("Starts the next attempt immediately after a rejection", func(t *testing.T) {
+		attempts := &hedgeAttempts{}
+		value := fakerInstance.Int()
+
+		promise := Hedge(attempts.factory(func(attempt int) *Promise {
+			if 1 == attempt {
+				return Reject(errors.New(fakerInstance.Lorem().Sentence(6)))
+			}
+
+			return Resolve(value)
+		}), time.Hour, 2)
+
+		require.Equal(t, StateFulfilled, promise.State())
+		require.Equal(t, value, promise.value)
+		require.Equal(t, []int{1, 2}, attempts.Attempts())
+	})
+
+	t.Run("Rejects when all attempts are rejected", func(t *testing.T) {
+		attempts := &hedgeAttempts{}
+		reasons := []error{
+			errors.New(fakerInstance.Lorem().Sentence(6)),
+			errors.New(fakerInstance.Lorem().Sentence(6)),
+		}
+
+		promise := Hedge(attempts.factory(func(attempt int) *Promise {
+			return Reject(reasons[attempt-1])
+		}), time.Hour, 2)
+
+		require.Equal(t, StateRejected, promise.State())
+
+		var aggregateErr *AggregateError
+
+		require.True(t, errors.As(promise.err, &aggregateErr))
+		require.Equal(t, reasons, aggregateErr.Errors)
+	})
+
+	t.Run("Cancels attempts when canceled", func(t *testing.T) {
+		attempts := &hedgeAttempts{}
+
+		promise := Hedge(attempts.factory(func(_ int) *Promise {
+			return Pending()
+		}), time.Hour, 2)
+
+		require.True(t, promise.Cancel())
+
+		require.Equal(t, []int{1}, attempts.Attempts())
+		require.Equal(t, StateRejected, attempts.Promise(0).State())
+		require.ErrorIs(t, attempts.Promise(0).err, ErrCanceled)
+	})
+}
@@ -9,16 +9,43 @@ const (
 	StateRejected  = State("rejected")
 )
 
+type HandlerKind string
+
+const (
+	HandlerThen         = HandlerKind("then")
+	HandlerThenCatch    = HandlerKind("thenCatch")
+	HandlerCatch        = HandlerKind("catch")
+	HandlerCatchIs      = HandlerKind("catchIs")
+	HandlerCatchAs      = HandlerKind("catchAs")
+	HandlerCatchAsync   = HandlerKind("catchAsync")
+	HandlerFinally      = HandlerKind("finally")
+	HandlerFinallyAsync = HandlerKind("finallyAsync")
+	HandlerTap          = HandlerKind("tap")
+	HandlerTapError     = HandlerKind("tapError")
+)
+
 type Resolver func(value interface{})
 type Rejector func(reason error)
 type FulfillHandler func(value interface{}) (result interface{}, err error)
 type RejectHandler func(reason error)
+type RecoverHandler func(reason error) (result interface{}, err error)
 type FinallyHandler func()
+type AsyncRejectHandler func(reason error) Promiser
+type AsyncFinallyHandler func() Promiser
+type TapHandler func(value interface{})
+type TapErrorHandler func(reason error)
 
 type Promiser interface {
 	Then(handler FulfillHandler) Promiser
+	ThenCatch(onFulfilled FulfillHandler, onRejected RecoverHandler) Promiser
 	Catch(handler RejectHandler) Promiser
+	CatchIs(target error, handler RejectHandler) Promiser
+	CatchAs(target interface{}, handler RejectHandler) Promiser
+	CatchAsync(handler AsyncRejectHandler) Promiser
 	Finally(handler FinallyHandler) Promiser
+	FinallyAsync(handler AsyncFinallyHandler) Promiser
+	Tap(handler TapHandler) Promiser
+	TapError(handler TapErrorHandler) Promiser
 	Resolve(value interface{}) error
 	Reject(reason error) error
 }
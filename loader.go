@@ -0,0 +1,186 @@
+package promise
+
+import (
+	"errors"
+	"runtime/debug"
+	"sync"
+	"time"
+)
+
+var ErrBatchResultMismatch = errors.New("batch function must return a value or an error for each key")
+
+type BatchFunc func(keys []interface{}) ([]interface{}, []error)
+
+type LoaderConfig struct {
+	Wait         time.Duration
+	MaxBatchSize int
+	Cache        *Cache
+}
+
+type Loader struct {
+	mutex sync.Mutex
+
+	rt          *Runtime
+	batchFn     BatchFunc
+	config      LoaderConfig
+	panicPolicy PanicPolicy
+	batch       *loaderBatch
+}
+
+type loaderBatch struct {
+	keys         []interface{}
+	promises     []*Promise
+	timer        *time.Timer
+	isDispatched bool
+}
+
+func NewLoader(batchFn BatchFunc, config LoaderConfig) *Loader {
+	return defaultRuntime.NewLoader(batchFn, config)
+}
+
+func (rt *Runtime) NewLoader(batchFn BatchFunc, config LoaderConfig) *Loader {
+	return &Loader{
+		rt:          rt,
+		batchFn:     batchFn,
+		config:      config,
+		panicPolicy: rt.makeOptions(nil).panicPolicy,
+	}
+}
+
+func (l *Loader) Load(key interface{}) *Promise {
+	if nil != l.config.Cache {
+		return l.config.Cache.Get(key, func(key interface{}) Promiser {
+			return l.enqueue(key)
+		})
+	}
+
+	return l.enqueue(key)
+}
+
+func (l *Loader) LoadMany(keys ...interface{}) *Promise {
+	promises := make([]Promiser, 0, len(keys))
+	for _, key := range keys {
+		promises = append(promises, l.Load(key))
+	}
+
+	return l.rt.All(promises...)
+}
+
+func (l *Loader) enqueue(key interface{}) *Promise {
+	p := l.rt.Pending()
+
+	l.mutex.Lock()
+
+	if nil == l.batch {
+		batch := &loaderBatch{}
+		batch.timer = time.AfterFunc(l.config.Wait, func() {
+			l.dispatch(batch)
+		})
+
+		l.batch = batch
+	}
+
+	batch := l.batch
+	batch.keys = append(batch.keys, key)
+	batch.promises = append(batch.promises, p)
+
+	isFull := 0 < l.config.MaxBatchSize && len(batch.keys) >= l.config.MaxBatchSize
+	if isFull {
+		l.batch = nil
+		batch.timer.Stop()
+	}
+
+	l.mutex.Unlock()
+
+	if isFull {
+		go l.dispatch(batch)
+	}
+
+	return p
+}
+
+func (l *Loader) dispatch(batch *loaderBatch) {
+	l.mutex.Lock()
+
+	if l.batch == batch {
+		l.batch = nil
+	}
+
+	if batch.isDispatched {
+		l.mutex.Unlock()
+
+		return
+	}
+
+	batch.isDispatched = true
+
+	l.mutex.Unlock()
+
+	values, errs, panicErr := l.load(batch.keys)
+
+	if nil != panicErr {
+		batch.reject(panicErr)
+
+		if PanicReject != l.panicPolicy {
+			panic(panicErr.Value)
+		}
+
+		return
+	}
+
+	if 1 == len(errs) && 1 < len(batch.keys) {
+		if nil != errs[0] {
+			batch.reject(errs[0])
+
+			return
+		}
+
+		errs = nil
+	}
+
+	if (0 != len(errs) && len(errs) != len(batch.keys)) || (len(values) != len(batch.keys) && !allErrors(errs)) {
+		batch.reject(ErrBatchResultMismatch)
+
+		return
+	}
+
+	for i, p := range batch.promises {
+		if 0 != len(errs) && nil != errs[i] {
+			_ = p.Reject(errs[i])
+		} else {
+			_ = p.Resolve(values[i])
+		}
+	}
+}
+
+func (l *Loader) load(keys []interface{}) (values []interface{}, errs []error, panicErr *PanicError) {
+	defer func() {
+		if recovered := recover(); nil != recovered {
+			panicErr = &PanicError{Value: recovered, Stack: debug.Stack()}
+		}
+	}()
+
+	values, errs = l.batchFn(keys)
+
+	return values, errs, nil
+}
+
+func (b *loaderBatch) reject(reason error) {
+	for _, p := range b.promises {
+		_ = p.Reject(reason)
+	}
+}
+
+func allErrors(errs []error) bool {
+	if 0 == len(errs) {
+		return false
+	}
+
+	for _, err := range errs {
+		if nil == err {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,197 @@
+package promise
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jaswdr/faker"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingBatchFunc struct {
+	mutex   sync.Mutex
+	batches [][]interface{}
+	results func(keys []interface{}) ([]interface{}, []error)
+}
+
+func (f *recordingBatchFunc) Load(keys []interface{}) ([]interface{}, []error) {
+	f.mutex.Lock()
+	f.batches = append(f.batches, keys)
+	f.mutex.Unlock()
+
+	return f.results(keys)
+}
+
+func (f *recordingBatchFunc) Batches() [][]interface{} {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	return append([][]interface{}(nil), f.batches...)
+}
+
+func describeKeys(keys []interface{}) ([]interface{}, []error) {
+	values := make([]interface{}, 0, len(keys))
+	for _, key := range keys {
+		values = append(values, fmt.Sprintf("value of %v", key))
+	}
+
+	return values, nil
+}
+
+func awaitPromise(t *testing.T, p *Promise) {
+	callsStack := newCallsRegistry(1)
+
+	p.Finally(func() {
+		callsStack.Register("Finally")
+	})
+
+	callsStack.AssertCompletedInOrderBefore(t, []string{"Finally"}, time.Second)
+}
+
+func TestLoader(t *testing.T) {
+	fakerInstance := faker.New()
+
+	t.Run("Batches keys requested within the window", func(t *testing.T) {
+		batchFn := &recordingBatchFunc{results: describeKeys}
+		loader := NewLoader(batchFn.Load, LoaderConfig{Wait: 10 * time.Millisecond})
+
+		first := loader.Load(1)
+		second := loader.Load(2)
+
+		awaitPromise(t, first)
+		awaitPromise(t, second)
+
+		require.Equal(t, "value of 1", first.value)
+		require.Equal(t, "value of 2", second.value)
+		require.Equal(t, [][]interface{}{{1, 2}}, batchFn.Batches())
+
+		third := loader.Load(3)
+
+		awaitPromise(t, third)
+
+		require.Equal(t, [][]interface{}{{1, 2}, {3}}, batchFn.Batches())
+	})
+
+	t.Run("Splits batches exceeding the maximum size", func(t *testing.T) {
+		batchFn := &recordingBatchFunc{results: describeKeys}
+		loader := NewLoader(batchFn.Load, LoaderConfig{Wait: time.Hour, MaxBatchSize: 2})
+
+		all := loader.LoadMany(1, 2, 3, 4)
+
+		awaitPromise(t, all)
+
+		require.Equal(t, []interface{}{"value of 1", "value of 2", "value of 3", "value of 4"}, all.value)
+		require.ElementsMatch(t, [][]interface{}{{1, 2}, {3, 4}}, batchFn.Batches())
+	})
+
+	t.Run("Rejects promises of keys with errors", func(t *testing.T) {
+		reason := errors.New(fakerInstance.Lorem().Sentence(6))
+		batchFn := &recordingBatchFunc{results: func(keys []interface{}) ([]interface{}, []error) {
+			return []interface{}{"value of 1", nil}, []error{nil, reason}
+		}}
+		loader := NewLoader(batchFn.Load, LoaderConfig{})
+
+		first := loader.Load(1)
+		second := loader.Load(2)
+
+		awaitPromise(t, first)
+		awaitPromise(t, second)
+
+		require.Equal(t, StateFulfilled, first.State())
+		require.Equal(t, "value of 1", first.value)
+		require.Equal(t, StateRejected, second.State())
+		require.Same(t, reason, second.err)
+	})
+
+	t.Run("Rejects all promises with a single batch error", func(t *testing.T) {
+		reason := errors.New(fakerInstance.Lorem().Sentence(6))
+		batchFn := &recordingBatchFunc{results: func(keys []interface{}) ([]interface{}, []error) {
+			return nil, []error{reason}
+		}}
+		loader := NewLoader(batchFn.Load, LoaderConfig{})
+
+		first := loader.Load(1)
+		second := loader.Load(2)
+
+		awaitPromise(t, first)
+		awaitPromise(t, second)
+
+		require.Same(t, reason, first.err)
+		require.Same(t, reason, second.err)
+	})
+
+	t.Run("Rejects all promises when results do not match keys", func(t *testing.T) {
+		batchFn := &recordingBatchFunc{results: func(keys []interface{}) ([]interface{}, []error) {
+			return []interface{}{"value"}, nil
+		}}
+		loader := NewLoader(batchFn.Load, LoaderConfig{})
+
+		first := loader.Load(1)
+		second := loader.Load(2)
+
+		awaitPromise(t, first)
+		awaitPromise(t, second)
+
+		require.Same(t, ErrBatchResultMismatch, first.err)
+		require.Same(t, ErrBatchResultMismatch, second.err)
+	})
+
+	t.Run("Caches loaded keys", func(t *testing.T) {
+		batchFn := &recordingBatchFunc{results: describeKeys}
+		loader := NewLoader(batchFn.Load, LoaderConfig{Cache: NewCache(CacheConfig{})})
+
+		first := loader.Load(1)
+
+		require.Same(t, first, loader.Load(1))
+
+		awaitPromise(t, first)
+
+		require.Same(t, first, loader.Load(1))
+
+		second := loader.Load(2)
+
+		awaitPromise(t, second)
+
+		require.Equal(t, [][]interface{}{{1}, {2}}, batchFn.Batches())
+	})
+	t.Run("Rejects all promises when batch function panics", func(t *testing.T) {
+		loader := NewRuntime(WithPanicPolicy(PanicReject)).NewLoader(func(keys []interface{}) ([]interface{}, []error) {
+			panic("boom")
+		}, LoaderConfig{})
+
+		first := loader.Load(1)
+		second := loader.Load(2)
+
+		awaitPromise(t, first)
+		awaitPromise(t, second)
+
+		for _, promise := range []*Promise{first, second} {
+			var panicErr *PanicError
+
+			require.True(t, errors.As(promise.err, &panicErr))
+			require.Equal(t, "boom", panicErr.Value)
+		}
+	})
+
+	t.Run("Propagates batch function panics by default after rejecting promises", func(t *testing.T) {
+		loader := NewLoader(func(keys []interface{}) ([]interface{}, []error) {
+			panic("boom")
+		}, LoaderConfig{Wait: time.Hour})
+
+		promise := loader.Load(1)
+		batch := loader.batch
+		batch.timer.Stop()
+
+		require.PanicsWithValue(t, "boom", func() {
+			loader.dispatch(batch)
+		})
+
+		var panicErr *PanicError
+
+		require.Equal(t, StateRejected, promise.State())
+		require.True(t, errors.As(promise.err, &panicErr))
+	})
+}
@@ -0,0 +1,202 @@
+package promise
+
+import (
+	"encoding/json"
+	"expvar"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const unnamedMetricsKey = "unnamed"
+
+var DefaultMetricsBuckets = []time.Duration{
+	time.Millisecond,
+	5 * time.Millisecond,
+	10 * time.Millisecond,
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	500 * time.Millisecond,
+	time.Second,
+	5 * time.Second,
+	10 * time.Second,
+}
+
+type Metrics interface {
+	PromiseCreated()
+	PromiseSettled(name string, state State, latency time.Duration)
+	HandlerFinished(name string, kind HandlerKind, duration time.Duration)
+}
+
+var defaultMetrics atomic.Value
+
+type metricsHolder struct {
+	metrics Metrics
+}
+
+func SetMetrics(metrics Metrics) {
+	defaultMetrics.Store(metricsHolder{metrics: metrics})
+}
+
+func currentMetrics() Metrics {
+	if holder, ok := defaultMetrics.Load().(metricsHolder); ok {
+		return holder.metrics
+	}
+
+	return nil
+}
+
+type HistogramBucket struct {
+	UpperBound time.Duration `json:"le"`
+	Count      uint64        `json:"count"`
+}
+
+type HistogramSnapshot struct {
+	Count    uint64            `json:"count"`
+	Sum      time.Duration     `json:"sum"`
+	Buckets  []HistogramBucket `json:"buckets"`
+	Overflow uint64            `json:"overflow"`
+}
+
+type MetricsSnapshot struct {
+	Created         int64                        `json:"created"`
+	Fulfilled       int64                        `json:"fulfilled"`
+	Rejected        int64                        `json:"rejected"`
+	Pending         int64                        `json:"pending"`
+	SettleLatency   map[string]HistogramSnapshot `json:"settle_latency"`
+	HandlerDuration map[string]HistogramSnapshot `json:"handler_duration"`
+}
+
+func NewMetricsCollector(buckets ...time.Duration) *MetricsCollector {
+	if 0 == len(buckets) {
+		buckets = DefaultMetricsBuckets
+	}
+
+	sortedBuckets := make([]time.Duration, len(buckets))
+	copy(sortedBuckets, buckets)
+
+	sort.Slice(sortedBuckets, func(i, j int) bool {
+		return sortedBuckets[i] < sortedBuckets[j]
+	})
+
+	return &MetricsCollector{
+		buckets:         sortedBuckets,
+		settleLatency:   make(map[string]*histogram),
+		handlerDuration: make(map[string]*histogram),
+	}
+}
+
+type MetricsCollector struct {
+	created   int64
+	fulfilled int64
+	rejected  int64
+
+	mutex           sync.Mutex
+	buckets         []time.Duration
+	settleLatency   map[string]*histogram
+	handlerDuration map[string]*histogram
+}
+
+func (c *MetricsCollector) PromiseCreated() {
+	atomic.AddInt64(&c.created, 1)
+}
+
+func (c *MetricsCollector) PromiseSettled(name string, state State, latency time.Duration) {
+	switch state {
+	case StateFulfilled:
+		atomic.AddInt64(&c.fulfilled, 1)
+
+	case StateRejected:
+		atomic.AddInt64(&c.rejected, 1)
+	}
+
+	c.observe(c.settleLatency, name, latency)
+}
+
+func (c *MetricsCollector) HandlerFinished(name string, _ HandlerKind, duration time.Duration) {
+	c.observe(c.handlerDuration, name, duration)
+}
+
+func (c *MetricsCollector) Snapshot() MetricsSnapshot {
+	snapshot := MetricsSnapshot{
+		Created:         atomic.LoadInt64(&c.created),
+		Fulfilled:       atomic.LoadInt64(&c.fulfilled),
+		Rejected:        atomic.LoadInt64(&c.rejected),
+		SettleLatency:   make(map[string]HistogramSnapshot),
+		HandlerDuration: make(map[string]HistogramSnapshot),
+	}
+
+	snapshot.Pending = snapshot.Created - snapshot.Fulfilled - snapshot.Rejected
+
+	c.mutex.Lock()
+	for name, h := range c.settleLatency {
+		snapshot.SettleLatency[name] = h.snapshot(c.buckets)
+	}
+
+	for name, h := range c.handlerDuration {
+		snapshot.HandlerDuration[name] = h.snapshot(c.buckets)
+	}
+	c.mutex.Unlock()
+
+	return snapshot
+}
+
+func (c *MetricsCollector) String() string {
+	encoded, err := json.Marshal(c.Snapshot())
+	if nil != err {
+		return "{}"
+	}
+
+	return string(encoded)
+}
+
+func (c *MetricsCollector) Publish(name string) {
+	expvar.Publish(name, c)
+}
+
+func (c *MetricsCollector) observe(histograms map[string]*histogram, name string, duration time.Duration) {
+	if "" == name {
+		name = unnamedMetricsKey
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	h, exists := histograms[name]
+	if !exists {
+		h = &histogram{counts: make([]uint64, len(c.buckets)+1)}
+		histograms[name] = h
+	}
+
+	h.count++
+	h.sum += duration
+	h.counts[sort.Search(len(c.buckets), func(i int) bool {
+		return duration <= c.buckets[i]
+	})]++
+}
+
+type histogram struct {
+	count  uint64
+	sum    time.Duration
+	counts []uint64
+}
+
+func (h *histogram) snapshot(bounds []time.Duration) HistogramSnapshot {
+	snapshot := HistogramSnapshot{
+		Count:    h.count,
+		Sum:      h.sum,
+		Buckets:  make([]HistogramBucket, len(bounds)),
+		Overflow: h.counts[len(bounds)],
+	}
+
+	var cumulative uint64
+
+	for i, bound := range bounds {
+		cumulative += h.counts[i]
+
+		snapshot.Buckets[i] = HistogramBucket{UpperBound: bound, Count: cumulative}
+	}
+
+	return snapshot
+}
@@ -0,0 +1,131 @@
+package promise
+
+import (
+	"encoding/json"
+	"errors"
+	"expvar"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/jaswdr/faker"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetMetrics(t *testing.T) {
+	fakerInstance := faker.New()
+
+	t.Run("Counts created, settled and pending promises", func(t *testing.T) {
+		collector := NewMetricsCollector()
+
+		SetMetrics(collector)
+		defer SetMetrics(nil)
+
+		callsStack := newCallsRegistry(1)
+
+		promise := Pending().Named("fetch")
+		promise.Then(func(value interface{}) (interface{}, error) {
+			return nil, errors.New(fakerInstance.Lorem().Sentence(6))
+		}).Catch(func(reason error) {
+			callsStack.Register("Catch")
+		})
+
+		Resolve(fakerInstance.Int())
+		Pending()
+
+		snapshot := collector.Snapshot()
+
+		require.Equal(t, int64(5), snapshot.Created)
+		require.Equal(t, int64(1), snapshot.Fulfilled)
+		require.Equal(t, int64(0), snapshot.Rejected)
+		require.Equal(t, int64(4), snapshot.Pending)
+
+		require.NoError(t, promise.Resolve(fakerInstance.Int()))
+
+		callsStack.AssertCompletedInOrder(t, []string{"Catch"})
+
+		snapshot = collector.Snapshot()
+
+		require.Equal(t, int64(5), snapshot.Created)
+		require.Equal(t, int64(3), snapshot.Fulfilled)
+		require.Equal(t, int64(1), snapshot.Rejected)
+		require.Equal(t, int64(1), snapshot.Pending)
+
+		require.Len(t, snapshot.SettleLatency, 4)
+		require.Equal(t, uint64(1), snapshot.SettleLatency["fetch"].Count)
+		require.Equal(t, uint64(1), snapshot.SettleLatency["fetch.then"].Count)
+		require.Equal(t, uint64(1), snapshot.SettleLatency["fetch.then.catch"].Count)
+		require.Equal(t, uint64(1), snapshot.SettleLatency[unnamedMetricsKey].Count)
+
+		require.Len(t, snapshot.HandlerDuration, 2)
+		require.Equal(t, uint64(1), snapshot.HandlerDuration["fetch.then"].Count)
+		require.Equal(t, uint64(1), snapshot.HandlerDuration["fetch.then.catch"].Count)
+	})
+
+	t.Run("Does not collect metrics when disabled", func(t *testing.T) {
+		collector := NewMetricsCollector()
+
+		SetMetrics(collector)
+		SetMetrics(nil)
+
+		Resolve(fakerInstance.Int())
+
+		require.Equal(t, MetricsSnapshot{
+			SettleLatency:   map[string]HistogramSnapshot{},
+			HandlerDuration: map[string]HistogramSnapshot{},
+		}, collector.Snapshot())
+	})
+}
+
+func TestMetricsCollector_Histograms(t *testing.T) {
+	collector := NewMetricsCollector(time.Second, 10*time.Millisecond, 100*time.Millisecond)
+
+	for _, latency := range []time.Duration{5 * time.Millisecond, 10 * time.Millisecond, 20 * time.Millisecond, 200 * time.Millisecond, 2 * time.Second} {
+		collector.PromiseSettled("fetch", StateFulfilled, latency)
+	}
+
+	collector.HandlerFinished("", HandlerThen, 50*time.Millisecond)
+
+	snapshot := collector.Snapshot()
+
+	require.Equal(t, HistogramSnapshot{
+		Count: 5,
+		Sum:   2235 * time.Millisecond,
+		Buckets: []HistogramBucket{
+			{UpperBound: 10 * time.Millisecond, Count: 2},
+			{UpperBound: 100 * time.Millisecond, Count: 3},
+			{UpperBound: time.Second, Count: 4},
+		},
+		Overflow: 1,
+	}, snapshot.SettleLatency["fetch"])
+
+	require.Equal(t, HistogramSnapshot{
+		Count: 1,
+		Sum:   50 * time.Millisecond,
+		Buckets: []HistogramBucket{
+			{UpperBound: 10 * time.Millisecond, Count: 0},
+			{UpperBound: 100 * time.Millisecond, Count: 1},
+			{UpperBound: time.Second, Count: 1},
+		},
+	}, snapshot.HandlerDuration[unnamedMetricsKey])
+}
+
+func TestMetricsCollector_Publish(t *testing.T) {
+	name := fmt.Sprintf("promise_metrics_%d", time.Now().UnixNano())
+
+	collector := NewMetricsCollector()
+	collector.PromiseCreated()
+	collector.PromiseCreated()
+	collector.PromiseSettled("fetch", StateRejected, time.Millisecond)
+
+	collector.Publish(name)
+
+	published := expvar.Get(name)
+	require.NotNil(t, published)
+
+	var snapshot MetricsSnapshot
+
+	require.NoError(t, json.Unmarshal([]byte(published.String()), &snapshot))
+	require.Equal(t, collector.Snapshot(), snapshot)
+	require.Equal(t, int64(1), snapshot.Pending)
+}
@@ -0,0 +1,194 @@
+package promise
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+	"time"
+)
+
+const (
+	PanicPropagate = PanicPolicy("propagate")
+	PanicReject    = PanicPolicy("reject")
+)
+
+type PanicPolicy string
+
+type Executor interface {
+	Execute(task func())
+}
+
+type ExecutorFunc func(task func())
+
+func (f ExecutorFunc) Execute(task func()) {
+	f(task)
+}
+
+var GoroutineExecutor Executor = ExecutorFunc(func(task func()) {
+	go task()
+})
+
+type PanicError struct {
+	Value interface{}
+	Stack []byte
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("promise executor panicked: %v", e.Value)
+}
+
+func (e *PanicError) Unwrap() error {
+	if err, ok := e.Value.(error); ok {
+		return err
+	}
+
+	return nil
+}
+
+type Option func(o *options)
+
+type options struct {
+	executor     Executor
+	ctx          context.Context
+	name         string
+	labels       map[string]string
+	timeout      time.Duration
+	panicPolicy  PanicPolicy
+	tracer       Tracer
+	tracerIsSet  bool
+	metrics      Metrics
+	metricsIsSet bool
+	clock        Clock
+
+	capturesCreationSite bool
+	recordsGraph         bool
+	onUnhandledRejection UnhandledRejectionHandler
+}
+
+func WithExecutor(executor Executor) Option {
+	return func(o *options) {
+		o.executor = executor
+	}
+}
+
+func WithContext(ctx context.Context) Option {
+	return func(o *options) {
+		o.ctx = ctx
+	}
+}
+
+func WithName(name string) Option {
+	return func(o *options) {
+		o.name = name
+	}
+}
+
+func WithLabels(labels map[string]string) Option {
+	return func(o *options) {
+		merged := make(map[string]string, len(o.labels)+len(labels))
+		for key, value := range o.labels {
+			merged[key] = value
+		}
+		for key, value := range labels {
+			merged[key] = value
+		}
+
+		o.labels = merged
+	}
+}
+
+func WithTimeout(timeout time.Duration) Option {
+	return func(o *options) {
+		o.timeout = timeout
+	}
+}
+
+func WithPanicPolicy(policy PanicPolicy) Option {
+	return func(o *options) {
+		o.panicPolicy = policy
+	}
+}
+
+func WithTracer(tracer Tracer) Option {
+	return func(o *options) {
+		o.tracer = tracer
+		o.tracerIsSet = true
+	}
+}
+
+func WithMetrics(metrics Metrics) Option {
+	return func(o *options) {
+		o.metrics = metrics
+		o.metricsIsSet = true
+	}
+}
+
+func WithClock(clock Clock) Option {
+	return func(o *options) {
+		o.clock = clock
+	}
+}
+
+func WithCreationSite() Option {
+	return func(o *options) {
+		o.capturesCreationSite = true
+	}
+}
+
+func WithChainGraph() Option {
+	return func(o *options) {
+		o.recordsGraph = true
+	}
+}
+
+func WithUnhandledRejectionHandler(handler UnhandledRejectionHandler) Option {
+	return func(o *options) {
+		o.onUnhandledRejection = handler
+	}
+}
+
+func (p *Promise) runExecutor(callback func(resolve Resolver, reject Rejector), policy PanicPolicy) {
+	if PanicReject == policy {
+		defer func() {
+			if recovered := recover(); nil != recovered {
+				p.reject(p.wrapError(&PanicError{Value: recovered, Stack: debug.Stack()}))
+			}
+		}()
+	}
+
+	callback(p.resolve, p.reject)
+}
+
+func (p *Promise) watchContext(o options) {
+	if nil == o.ctx && 0 >= o.timeout {
+		return
+	}
+
+	ctx := o.ctx
+	if nil == ctx {
+		ctx = context.Background()
+	}
+
+	var cancel context.CancelFunc
+	if 0 < o.timeout {
+		ctx, cancel = context.WithTimeout(ctx, o.timeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+
+	settled := make(chan struct{})
+
+	p.onSettled(func() {
+		close(settled)
+		cancel()
+	})
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			p.abort(ctx.Err())
+
+		case <-settled:
+		}
+	}()
+}
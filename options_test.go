@@ -0,0 +1,251 @@
+package promise
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jaswdr/faker"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithName(t *testing.T) {
+	fakerInstance := faker.New()
+
+	name := fakerInstance.Lorem().Word()
+
+	for _, promise := range []*Promise{
+		NewPromise(func(resolve Resolver, _ Rejector) {
+			resolve(nil)
+		}, WithName(name)),
+		Pending(WithName(name)),
+		Resolve(fakerInstance.Int(), WithName(name)),
+		Resolve(Pending(), WithName(name)),
+		Reject(errors.New(fakerInstance.Lorem().Sentence(6)), WithName(name)),
+	} {
+		require.Equal(t, name, promise.Name())
+	}
+}
+
+func TestWithLabels(t *testing.T) {
+	fakerInstance := faker.New()
+
+	labels := map[string]string{
+		"tenant": fakerInstance.Lorem().Word(),
+		"region": fakerInstance.Lorem().Word(),
+	}
+
+	for _, promise := range []*Promise{
+		NewPromise(func(resolve Resolver, _ Rejector) {
+			resolve(nil)
+		}, WithLabels(labels)),
+		Pending(WithLabels(labels)),
+		Resolve(fakerInstance.Int(), WithLabels(labels)),
+		Resolve(Pending(), WithLabels(labels)),
+		Reject(errors.New(fakerInstance.Lorem().Sentence(6)), WithLabels(labels)),
+	} {
+		require.Equal(t, labels, promise.Labels())
+	}
+
+	t.Run("Merges labels of several options", func(t *testing.T) {
+		promise := Pending(WithLabels(map[string]string{"a": "1", "b": "2"}), WithLabels(map[string]string{"b": "3"}))
+
+		require.Equal(t, map[string]string{"a": "1", "b": "3"}, promise.Labels())
+	})
+
+	t.Run("Does not share labels with the given map", func(t *testing.T) {
+		given := map[string]string{"a": "1"}
+		promise := Pending(WithLabels(given))
+
+		given["a"] = "2"
+
+		require.Equal(t, map[string]string{"a": "1"}, promise.Labels())
+	})
+
+	t.Run("Is inherited by derived promises", func(t *testing.T) {
+		derived := Pending(WithLabels(labels)).Then(func(value interface{}) (interface{}, error) {
+			return value, nil
+		}).(*Promise)
+
+		require.Equal(t, labels, derived.Labels())
+	})
+}
+
+func TestWithExecutor(t *testing.T) {
+	fakerInstance := faker.New()
+
+	value := fakerInstance.Int()
+	tasks := 0
+
+	promise := NewPromise(func(resolve Resolver, _ Rejector) {
+		resolve(value)
+	}, WithExecutor(ExecutorFunc(func(task func()) {
+		tasks++
+
+		task()
+	})))
+
+	require.Equal(t, 1, tasks)
+	require.Equal(t, StateFulfilled, promise.State())
+	require.Equal(t, value, promise.value)
+}
+
+func TestWithContext(t *testing.T) {
+	fakerInstance := faker.New()
+
+	t.Run("Rejects promise when context is canceled", func(t *testing.T) {
+		callsStack := newCallsRegistry(1)
+
+		ctx, cancel := context.WithCancel(context.Background())
+
+		promise := Pending(WithContext(ctx), WithName("fetch"))
+		promise.Catch(func(reason error) {
+			require.ErrorIs(t, reason, context.Canceled)
+			require.EqualError(t, reason, `promise "fetch": context canceled`)
+
+			callsStack.Register("Catch")
+		})
+
+		cancel()
+
+		callsStack.AssertCompletedInOrderBefore(t, []string{"Catch"}, time.Second)
+	})
+
+	t.Run("Does not affect settled promise", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+
+		value := fakerInstance.Int()
+		promise := Pending(WithContext(ctx))
+
+		require.NoError(t, promise.Resolve(value))
+
+		cancel()
+
+		time.Sleep(20 * time.Millisecond)
+
+		require.Equal(t, StateFulfilled, promise.State())
+		require.Equal(t, value, promise.value)
+	})
+}
+
+func TestWithTimeout(t *testing.T) {
+	fakerInstance := faker.New()
+
+	t.Run("Rejects promise whose executor does not settle in time", func(t *testing.T) {
+		callsStack := newCallsRegistry(1)
+		release := make(chan struct{})
+		defer close(release)
+
+		promise := NewPromise(func(resolve Resolver, _ Rejector) {
+			<-release
+
+			resolve(nil)
+		}, WithTimeout(20*time.Millisecond))
+
+		promise.Catch(func(reason error) {
+			require.ErrorIs(t, reason, context.DeadlineExceeded)
+
+			callsStack.Register("Catch")
+		})
+
+		callsStack.AssertCompletedInOrderBefore(t, []string{"Catch"}, time.Second)
+	})
+
+	t.Run("Does not affect promise settled in time", func(t *testing.T) {
+		value := fakerInstance.Int()
+		promise := NewPromise(func(resolve Resolver, _ Rejector) {
+			resolve(value)
+		}, WithTimeout(20*time.Millisecond))
+
+		time.Sleep(50 * time.Millisecond)
+
+		require.Equal(t, StateFulfilled, promise.State())
+		require.Equal(t, value, promise.value)
+	})
+}
+
+func TestWithPanicPolicy(t *testing.T) {
+	fakerInstance := faker.New()
+
+	t.Run("Rejects promise when executor panics", func(t *testing.T) {
+		callsStack := newCallsRegistry(1)
+
+		reason := errors.New(fakerInstance.Lorem().Sentence(6))
+
+		NewPromise(func(_ Resolver, _ Rejector) {
+			panic(reason)
+		}, WithPanicPolicy(PanicReject)).Catch(func(caughtReason error) {
+			var panicErr *PanicError
+
+			require.True(t, errors.As(caughtReason, &panicErr))
+			require.Same(t, reason, panicErr.Value)
+			require.NotEmpty(t, panicErr.Stack)
+			require.ErrorIs(t, caughtReason, reason)
+			require.EqualError(t, caughtReason, "promise executor panicked: "+reason.Error())
+
+			callsStack.Register("Catch")
+		})
+
+		callsStack.AssertCompletedInOrderBefore(t, []string{"Catch"}, time.Second)
+	})
+
+	t.Run("Keeps the value of non-error panics", func(t *testing.T) {
+		callsStack := newCallsRegistry(1)
+
+		NewPromise(func(_ Resolver, _ Rejector) {
+			panic("boom")
+		}, WithPanicPolicy(PanicReject)).Catch(func(caughtReason error) {
+			var panicErr *PanicError
+
+			require.True(t, errors.As(caughtReason, &panicErr))
+			require.Equal(t, "boom", panicErr.Value)
+			require.Nil(t, panicErr.Unwrap())
+
+			callsStack.Register("Catch")
+		})
+
+		callsStack.AssertCompletedInOrderBefore(t, []string{"Catch"}, time.Second)
+	})
+
+	t.Run("Propagates panics by default", func(t *testing.T) {
+		recovered := make(chan interface{}, 1)
+
+		NewPromise(func(_ Resolver, _ Rejector) {
+			panic("boom")
+		}, WithExecutor(ExecutorFunc(func(task func()) {
+			defer func() {
+				recovered <- recover()
+			}()
+
+			task()
+		})))
+
+		require.Equal(t, "boom", <-recovered)
+	})
+}
+
+func TestWithTracer(t *testing.T) {
+	fakerInstance := faker.New()
+
+	recorder := NewTraceRecorder()
+
+	promise := Pending(WithTracer(recorder))
+	thenPromise := promise.Then(func(value interface{}) (interface{}, error) {
+		return value, nil
+	}).(*Promise)
+
+	Pending()
+
+	require.NoError(t, promise.Resolve(fakerInstance.Int()))
+
+	require.Equal(t, []traceEventSummary{
+		{Type: TraceEventCreated, PromiseID: promise.ID(), State: StatePending},
+		{Type: TraceEventCreated, PromiseID: thenPromise.ID(), State: StateSettling},
+		{Type: TraceEventChained, PromiseID: thenPromise.ID(), ParentID: promise.ID(), Handler: HandlerThen},
+		{Type: TraceEventSettled, PromiseID: promise.ID(), State: StateFulfilled},
+		{Type: TraceEventHandlerStarted, PromiseID: thenPromise.ID(), ParentID: promise.ID(), Handler: HandlerThen},
+		{Type: TraceEventHandlerFinished, PromiseID: thenPromise.ID(), ParentID: promise.ID(), Handler: HandlerThen},
+		{Type: TraceEventSettled, PromiseID: thenPromise.ID(), State: StateFulfilled},
+	}, summarizeTraceEvents(recorder.Events()))
+}
@@ -2,92 +2,398 @@ package promise
 
 import (
 	"errors"
+	"fmt"
+	"reflect"
 	"sync"
+	"sync/atomic"
+	"time"
 )
 
 var (
 	ErrResolveNotPendingPromise = errors.New("cannot resolve promise that is not in pending state")
 	ErrRejectNotPendingPromise  = errors.New("cannot reject promise that is not in pending state")
+	ErrChainingCycle            = errors.New("chaining cycle detected for promise")
+	ErrInvalidCatchAsTarget     = errors.New("catch as target must be a non-nil pointer to an interface or to a type implementing error")
+	ErrCanceled                 = errors.New("promise canceled")
 )
 
+var lastPromiseID uint64
+
+const handlerAdopt = HandlerKind("adopt")
+
+type chainLink struct {
+	promise *Promise
+	kind    HandlerKind
+}
+
 type Promise struct {
+	id    uint64
 	mutex sync.RWMutex
 	state State
 
 	handlers   []func()
 	operations []func()
+	notifying  bool
+	adopted    Promiser
+	follows    *Promise
+
+	recordsGraph    bool
+	children        []chainLink
+	droppedChildren bool
 
 	value interface{}
 	err   error
+
+	name    string
+	labels  map[string]string
+	tracer  Tracer
+	metrics Metrics
+	clock   Clock
+
+	onUnhandledRejection UnhandledRejectionHandler
+	handled              bool
+
+	kind                 HandlerKind
+	capturesCreationSite bool
+	stack                []uintptr
+	stackIsFull          bool
+	asyncStack           bool
+	createdAt            time.Time
 }
 
-func NewPromise(callback func(resolve Resolver, reject Rejector)) *Promise {
-	p := Promise{
-		state: StateSettling,
-	}
+func NewPromise(callback func(resolve Resolver, reject Rejector), opts ...Option) *Promise {
+	return defaultRuntime.NewPromise(callback, opts...)
+}
 
-	go func() {
-		callback(p.resolve, p.reject)
+func Pending(opts ...Option) *Promise {
+	return defaultRuntime.Pending(opts...)
+}
 
-		p.mutex.RLock()
+func Resolve(value interface{}, opts ...Option) *Promise {
+	return defaultRuntime.Resolve(value, opts...)
+}
+
+func Reject(reason error, opts ...Option) *Promise {
+	return defaultRuntime.Reject(reason, opts...)
+}
+
+func All(promises ...Promiser) *Promise {
+	return defaultRuntime.All(promises...)
+}
+
+func Race(promises ...Promiser) *Promise {
+	return defaultRuntime.Race(promises...)
+}
+
+func newPromise(callback func(resolve Resolver, reject Rejector), o options) *Promise {
+	p := makePromise(StateSettling, nil, nil, o)
+
+	trackExecutor(p)
+
+	o.executor.Execute(func() {
+		p.runExecutor(callback, o.panicPolicy)
+
+		untrackExecutor(p)
+
+		p.mutex.Lock()
 
 		if StateSettling == p.state {
 			p.state = StatePending
 
-			p.mutex.RUnlock()
+			p.mutex.Unlock()
 
 			return
 		}
 
-		p.mutex.RUnlock()
+		p.mutex.Unlock()
 
 		p.notifyObservers()
-	}()
+	})
+
+	return p
+}
+
+func newResolved(value interface{}, o options) *Promise {
+	if _, ok := asPromiser(value); ok {
+		p := makePromise(StatePending, nil, nil, o)
+
+		_ = p.Resolve(value)
+
+		return p
+	}
+
+	return makePromise(StateFulfilled, value, nil, o)
+}
+
+func makePromise(state State, value interface{}, reason error, o options) *Promise {
+	p := allocatePromise(state, o.clock, o.capturesCreationSite)
+	p.name = o.name
+	p.labels = o.labels
+	p.recordsGraph = o.recordsGraph
+	p.value = value
+	p.err = p.withAsyncStack(reason)
+	p.tracer = o.tracer
+	p.metrics = o.metrics
+	p.onUnhandledRejection = o.onUnhandledRejection
+
+	if nil != p.tracer {
+		p.tracer.PromiseCreated(p)
+
+		if StateFulfilled == state || StateRejected == state {
+			p.tracer.PromiseSettled(p)
+		}
+	}
+
+	if nil != p.metrics {
+		p.metrics.PromiseCreated()
 
-	return &p
+		if StateFulfilled == state || StateRejected == state {
+			p.metrics.PromiseSettled(p.name, state, 0)
+		}
+	}
+
+	if StatePending == state || StateSettling == state {
+		p.watchContext(o)
+	} else if StateRejected == state {
+		p.watchUnhandledRejection()
+	}
+
+	return p
 }
 
-func Pending() *Promise {
-	p := Promise{
-		state: StatePending,
+func (p *Promise) makeDerived(kind HandlerKind) *Promise {
+	newPromise := allocatePromise(StateSettling, p.clock, p.capturesCreationSite)
+	newPromise.kind = kind
+
+	p.mutex.Lock()
+	p.handled = true
+	if "" != p.name {
+		newPromise.name = p.name + "." + string(kind)
+	}
+	newPromise.labels = p.labels
+	newPromise.tracer = p.tracer
+	newPromise.metrics = p.metrics
+	newPromise.onUnhandledRejection = p.onUnhandledRejection
+	newPromise.recordsGraph = p.recordsGraph
+	if p.recordsGraph && handlerAdopt != kind {
+		p.recordChild(newPromise, kind)
+	}
+	p.mutex.Unlock()
+
+	if nil != newPromise.tracer {
+		newPromise.tracer.PromiseCreated(newPromise)
+		newPromise.tracer.PromiseChained(p, newPromise, kind)
 	}
 
-	return &p
+	if nil != newPromise.metrics {
+		newPromise.metrics.PromiseCreated()
+	}
+
+	return newPromise
 }
 
-func Resolve(value interface{}) *Promise {
-	return &Promise{
-		state: StateFulfilled,
-		value: value,
+func allocatePromise(state State, clock Clock, capturesCreationSite bool) *Promise {
+	if nil == clock {
+		clock = SystemClock
+	}
+
+	p := &Promise{
+		id:                   atomic.AddUint64(&lastPromiseID, 1),
+		state:                state,
+		clock:                clock,
+		createdAt:            clock.Now(),
+		capturesCreationSite: capturesCreationSite,
+	}
+
+	isTracked := (StatePending == state || StateSettling == state) && isTracking()
+	p.asyncStack = isAsyncStackTracing()
+
+	if isTracked || p.asyncStack {
+		p.stack, p.stackIsFull = callers(2, maxStackDepth), true
+	} else if capturesCreationSite {
+		p.stack = callers(2, creationSiteDepth)
 	}
+
+	if isTracked {
+		track(p)
+	}
+
+	return p
 }
 
-func Reject(reason error) *Promise {
-	return &Promise{
-		state: StateRejected,
-		err:   reason,
+func (p *Promise) Named(name string) *Promise {
+	p.mutex.Lock()
+	p.name = name
+	p.mutex.Unlock()
+
+	return p
+}
+
+func (p *Promise) Name() string {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	return p.name
+}
+
+func (p *Promise) Label(key, value string) *Promise {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	labels := make(map[string]string, len(p.labels)+1)
+	for existingKey, existingValue := range p.labels {
+		labels[existingKey] = existingValue
+	}
+	labels[key] = value
+
+	p.labels = labels
+
+	return p
+}
+
+func (p *Promise) Labels() map[string]string {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	labels := make(map[string]string, len(p.labels))
+	for key, value := range p.labels {
+		labels[key] = value
 	}
+
+	return labels
+}
+
+func (p *Promise) ID() uint64 {
+	return p.id
+}
+
+func (p *Promise) State() State {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	return p.state
 }
 
 func (p *Promise) Then(handler FulfillHandler) Promiser {
-	return p.registerHandlers(handler, nil, nil)
+	return p.thenCatch(HandlerThen, handler, nil)
+}
+
+func (p *Promise) ThenCatch(onFulfilled FulfillHandler, onRejected RecoverHandler) Promiser {
+	return p.thenCatch(HandlerThenCatch, onFulfilled, onRejected)
+}
+
+func (p *Promise) thenCatch(kind HandlerKind, onFulfilled FulfillHandler, onRejected RecoverHandler) *Promise {
+	return p.registerHandler(kind, func(newPromise *Promise) {
+		if StateFulfilled == p.state && nil != onFulfilled {
+			result, err := onFulfilled(p.value)
+
+			p.settleDerived(newPromise, result, err)
+		} else if StateRejected == p.state && nil != onRejected {
+			result, err := onRejected(p.err)
+
+			p.settleDerived(newPromise, result, err)
+		} else {
+			p.passDerived(newPromise)
+		}
+	})
 }
 
 func (p *Promise) Catch(handler RejectHandler) Promiser {
-	return p.registerHandlers(nil, handler, nil)
+	return p.catchMatching(HandlerCatch, nil, handler)
+}
+
+func (p *Promise) CatchIs(target error, handler RejectHandler) Promiser {
+	return p.catchMatching(HandlerCatchIs, func(reason error) bool {
+		return errors.Is(reason, target)
+	}, handler)
+}
+
+func (p *Promise) CatchAs(target interface{}, handler RejectHandler) Promiser {
+	if !isErrorTarget(target) {
+		panic(ErrInvalidCatchAsTarget)
+	}
+
+	return p.catchMatching(HandlerCatchAs, func(reason error) bool {
+		return errors.As(reason, target)
+	}, handler)
+}
+
+func (p *Promise) CatchAsync(handler AsyncRejectHandler) Promiser {
+	return p.registerHandler(HandlerCatchAsync, func(newPromise *Promise) {
+		if StateFulfilled == p.state {
+			p.passDerived(newPromise)
+
+			return
+		}
+
+		p.resolveDerived(newPromise, handler(p.err))
+	})
 }
 
 func (p *Promise) Finally(handler FinallyHandler) Promiser {
-	return p.registerHandlers(nil, nil, handler)
+	return p.registerHandler(HandlerFinally, func(newPromise *Promise) {
+		handler()
+
+		p.passDerived(newPromise)
+	})
+}
+
+func (p *Promise) FinallyAsync(handler AsyncFinallyHandler) Promiser {
+	return p.registerHandler(HandlerFinallyAsync, func(newPromise *Promise) {
+		cleanup := handler()
+		if nil == cleanup {
+			p.passDerived(newPromise)
+
+			return
+		}
+
+		p.awaitDerived(newPromise, cleanup, func(_ interface{}) {
+			if StateFulfilled == p.state {
+				_ = newPromise.Resolve(p.value)
+			} else {
+				_ = newPromise.Reject(p.err)
+			}
+		})
+	})
+}
+
+func (p *Promise) Tap(handler TapHandler) Promiser {
+	return p.registerHandler(HandlerTap, func(newPromise *Promise) {
+		if StateFulfilled == p.state {
+			handler(p.value)
+		}
+
+		p.passDerived(newPromise)
+	})
+}
+
+func (p *Promise) TapError(handler TapErrorHandler) Promiser {
+	return p.registerHandler(HandlerTapError, func(newPromise *Promise) {
+		if StateRejected == p.state {
+			handler(p.err)
+		}
+
+		p.passDerived(newPromise)
+	})
 }
 
 func (p *Promise) Resolve(value interface{}) error {
 	p.mutex.Lock()
 
-	if StatePending != p.state {
+	if StatePending != p.state || nil != p.adopted {
+		p.mutex.Unlock()
+
+		return p.wrapError(ErrResolveNotPendingPromise)
+	}
+
+	if adopted, ok := asPromiser(value); ok {
+		p.adopted = adopted
+
 		p.mutex.Unlock()
 
-		return ErrResolveNotPendingPromise
+		p.adopt(adopted)
+
+		return nil
 	}
 
 	p.state = StateFulfilled
@@ -95,6 +401,7 @@ func (p *Promise) Resolve(value interface{}) error {
 
 	p.mutex.Unlock()
 
+	p.reportSettled()
 	p.notifyObservers()
 
 	return nil
@@ -103,172 +410,407 @@ func (p *Promise) Resolve(value interface{}) error {
 func (p *Promise) Reject(reason error) error {
 	p.mutex.Lock()
 
-	if StatePending != p.state {
+	if StatePending != p.state || nil != p.adopted {
 		p.mutex.Unlock()
 
-		return ErrRejectNotPendingPromise
+		return p.wrapError(ErrRejectNotPendingPromise)
 	}
 
 	p.state = StateRejected
-	p.err = reason
+	p.err = p.withAsyncStack(reason)
 
 	p.mutex.Unlock()
 
+	p.reportSettled()
 	p.notifyObservers()
 
 	return nil
 }
 
-func (p *Promise) registerHandlers(
-	fulfillHandler FulfillHandler,
-	rejectHandler RejectHandler,
-	finallyHandler FinallyHandler,
-) *Promise {
-	newPromise := Promise{
-		state: StateSettling,
-	}
+func (p *Promise) Cancel() bool {
+	return p.abort(ErrCanceled)
+}
 
-	if nil != fulfillHandler {
-		handler := func() {
-			if StateRejected == p.state {
-				p.operations = append(p.operations, func() {
-					newPromise.state = StatePending
+func (p *Promise) catchMatching(kind HandlerKind, matches func(reason error) bool, handler RejectHandler) *Promise {
+	return p.registerHandler(kind, func(newPromise *Promise) {
+		if StateFulfilled == p.state || (nil != matches && !matches(p.err)) {
+			p.passDerived(newPromise)
 
-					_ = newPromise.Reject(p.err)
-				})
+			return
+		}
 
-				return
-			}
+		handler(p.err)
 
-			if result, err := fulfillHandler(p.value); err == nil {
-				if promiseResult, ok := result.(*Promise); ok {
-					p.operations = append(p.operations, func() {
-						newPromise.state = StatePending
+		p.resolveDerived(newPromise, nil)
+	})
+}
 
-						promiseResult.Then(func(value interface{}) (interface{}, error) {
-							_ = newPromise.Resolve(value)
+func (p *Promise) registerHandler(kind HandlerKind, handler func(newPromise *Promise)) *Promise {
+	newPromise := p.makeDerived(kind)
 
-							return value, nil
-						})
+	p.mutex.Lock()
+	p.handlers = append(p.handlers, func() {
+		if nil != newPromise.tracer {
+			newPromise.tracer.HandlerStarted(p, newPromise, kind)
+			defer newPromise.tracer.HandlerFinished(p, newPromise, kind)
+		}
 
-						promiseResult.Catch(func(reason error) {
-							_ = newPromise.Reject(reason)
-						})
-					})
-				} else {
-					p.operations = append(p.operations, func() {
-						newPromise.state = StatePending
+		if nil != newPromise.metrics {
+			startedAt := newPromise.now()
 
-						_ = newPromise.Resolve(result)
-					})
-				}
-			} else {
-				p.operations = append(p.operations, func() {
-					newPromise.state = StatePending
+			defer func() {
+				newPromise.metrics.HandlerFinished(newPromise.Name(), kind, newPromise.now().Sub(startedAt))
+			}()
+		}
 
-					_ = newPromise.Reject(err)
-				})
-			}
+		if isTracking() {
+			run := trackHandler(p, newPromise, kind)
+			defer untrackHandler(run)
 		}
 
-		p.mutex.Lock()
-		p.handlers = append(p.handlers, handler)
-		p.mutex.Unlock()
+		handler(newPromise)
+	})
+	p.mutex.Unlock()
+
+	p.mutex.RLock()
+	shouldCallHandlersImmediately := StatePending != p.state && StateSettling != p.state
+	p.mutex.RUnlock()
+
+	if shouldCallHandlersImmediately {
+		p.notifyObservers()
 	}
 
-	if nil != rejectHandler {
-		handler := func() {
-			if StateFulfilled == p.state {
-				p.operations = append(p.operations, func() {
-					newPromise.state = StatePending
+	return newPromise
+}
 
-					_ = newPromise.Resolve(p.value)
-				})
+func (p *Promise) onSettled(callback func()) {
+	p.mutex.Lock()
+	p.handlers = append(p.handlers, callback)
+	shouldCallHandlersImmediately := StatePending != p.state && StateSettling != p.state
+	p.mutex.Unlock()
 
-				return
-			}
+	if shouldCallHandlersImmediately {
+		p.notifyObservers()
+	}
+}
 
-			rejectHandler(p.err)
+func (p *Promise) resolveDerived(newPromise *Promise, value interface{}) {
+	p.operations = append(p.operations, func() {
+		if !newPromise.reopen() {
+			return
+		}
 
-			p.operations = append(p.operations, func() {
-				newPromise.state = StatePending
+		_ = newPromise.Resolve(value)
+	})
+}
 
-				_ = newPromise.Resolve(nil)
-			})
+func (p *Promise) rejectDerived(newPromise *Promise, reason error) {
+	p.operations = append(p.operations, func() {
+		if !newPromise.reopen() {
+			return
 		}
 
-		p.mutex.Lock()
-		p.handlers = append(p.handlers, handler)
-		p.mutex.Unlock()
+		_ = newPromise.Reject(reason)
+	})
+}
+
+func (p *Promise) settleDerived(newPromise *Promise, result interface{}, err error) {
+	if nil != err {
+		p.rejectDerived(newPromise, err)
+	} else {
+		p.resolveDerived(newPromise, result)
 	}
+}
 
-	if nil != finallyHandler {
-		handler := func() {
-			finallyHandler()
+func (p *Promise) passDerived(newPromise *Promise) {
+	if StateFulfilled == p.state {
+		p.resolveDerived(newPromise, p.value)
+	} else {
+		p.rejectDerived(newPromise, p.err)
+	}
+}
 
-			p.operations = append(p.operations, func() {
-				newPromise.state = StatePending
+func (p *Promise) awaitDerived(newPromise *Promise, awaited Promiser, fulfill func(value interface{})) {
+	p.operations = append(p.operations, func() {
+		if !newPromise.reopen() {
+			return
+		}
+
+		onFulfilled := func(value interface{}) (interface{}, error) {
+			fulfill(value)
 
-				if StateFulfilled == p.state {
-					_ = newPromise.Resolve(p.value)
-				} else {
-					_ = newPromise.Reject(p.err)
-				}
-			})
+			return nil, nil
 		}
 
-		p.mutex.Lock()
-		p.handlers = append(p.handlers, handler)
-		p.mutex.Unlock()
-	}
+		onRejected := func(reason error) (interface{}, error) {
+			_ = newPromise.Reject(reason)
 
-	p.mutex.RLock()
-	shouldCallHandlersImmediately := StatePending != p.state && StateSettling != p.state
-	p.mutex.RUnlock()
+			return nil, nil
+		}
 
-	if shouldCallHandlersImmediately {
-		p.notifyObservers()
+		if awaitedPromise, ok := awaited.(*Promise); ok {
+			awaitedPromise.thenCatch(handlerAdopt, onFulfilled, onRejected)
+
+			return
+		}
+
+		awaited.ThenCatch(onFulfilled, onRejected)
+	})
+}
+
+func (p *Promise) reopen() bool {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if StateSettling != p.state {
+		return false
 	}
 
-	return &newPromise
+	p.state = StatePending
+
+	return true
 }
 
 func (p *Promise) notifyObservers() {
+	untrack(p)
+
 	p.mutex.Lock()
-	defer p.mutex.Unlock()
 
-	for _, handler := range p.handlers {
-		handler()
+	if p.notifying {
+		p.mutex.Unlock()
+
+		return
 	}
 
-	for _, operation := range p.operations {
-		operation()
+	p.notifying = true
+
+	var handlers []func()
+
+	isCompleted := false
+
+	defer func() {
+		if isCompleted {
+			return
+		}
+
+		p.mutex.Lock()
+		p.handlers = append(handlers, p.handlers...)
+		p.notifying = false
+		p.mutex.Unlock()
+	}()
+
+	for 0 != len(p.handlers) || 0 != len(p.operations) {
+		handlers = p.handlers
+		p.handlers = nil
+
+		p.mutex.Unlock()
+
+		for 0 != len(handlers) {
+			handler := handlers[0]
+			handlers = handlers[1:]
+
+			handler()
+		}
+
+		for 0 != len(p.operations) {
+			operation := p.operations[0]
+			p.operations = p.operations[1:]
+
+			operation()
+		}
+
+		p.mutex.Lock()
 	}
 
-	p.handlers = nil
 	p.operations = nil
+	p.notifying = false
+	isCompleted = true
+
+	p.mutex.Unlock()
 }
 
 func (p *Promise) resolve(value interface{}) {
 	p.mutex.Lock()
-	defer p.mutex.Unlock()
 
-	if StateSettling != p.state {
+	if StateSettling != p.state || nil != p.adopted {
+		p.mutex.Unlock()
+
+		return
+	}
+
+	if adopted, ok := asPromiser(value); ok {
+		p.adopted = adopted
+
+		p.mutex.Unlock()
+
+		p.adopt(adopted)
+
 		return
 	}
 
 	p.state = StateFulfilled
 	p.value = value
+
+	p.mutex.Unlock()
+
+	p.reportSettled()
 }
 
 func (p *Promise) reject(reason error) {
 	p.mutex.Lock()
-	defer p.mutex.Unlock()
 
-	if StateSettling != p.state {
+	if StateSettling != p.state || nil != p.adopted {
+		p.mutex.Unlock()
+
 		return
 	}
 
 	p.state = StateRejected
-	p.err = reason
+	p.err = p.withAsyncStack(reason)
+
+	p.mutex.Unlock()
+
+	p.reportSettled()
+}
+
+func (p *Promise) adopt(adopted Promiser) {
+	if p.isFollowedBy(adopted) {
+		p.settle(StateRejected, nil, p.wrapError(ErrChainingCycle))
+
+		return
+	}
+
+	onFulfilled := func(value interface{}) (interface{}, error) {
+		p.settle(StateFulfilled, value, nil)
+
+		return nil, nil
+	}
+
+	onRejected := func(reason error) (interface{}, error) {
+		p.settle(StateRejected, nil, reason)
+
+		return nil, nil
+	}
+
+	if adoptedPromise, ok := adopted.(*Promise); ok {
+		p.mutex.Lock()
+		p.follows = adoptedPromise
+		p.mutex.Unlock()
+
+		adoptedPromise.thenCatch(handlerAdopt, onFulfilled, onRejected)
+
+		return
+	}
+
+	adopted.ThenCatch(onFulfilled, onRejected)
+}
+
+func asPromiser(value interface{}) (Promiser, bool) {
+	if promise, ok := value.(*Promise); ok && nil == promise {
+		return nil, false
+	}
+
+	promiser, ok := value.(Promiser)
+
+	return promiser, ok
+}
+
+func (p *Promise) isFollowedBy(adopted Promiser) bool {
+	current, ok := adopted.(*Promise)
+
+	for ok {
+		if current == p {
+			return true
+		}
+
+		current.mutex.RLock()
+		next := current.adopted
+		current.mutex.RUnlock()
+
+		current, ok = next.(*Promise)
+	}
+
+	return false
+}
+
+func (p *Promise) settle(state State, value interface{}, reason error) bool {
+	p.mutex.Lock()
+
+	if StateFulfilled == p.state || StateRejected == p.state {
+		p.mutex.Unlock()
+
+		return false
+	}
+
+	executorIsRunning := StateSettling == p.state
+
+	p.state = state
+	p.value = value
+	p.err = p.withAsyncStack(reason)
+	p.adopted = nil
+
+	p.mutex.Unlock()
+
+	p.reportSettled()
+
+	if !executorIsRunning {
+		p.notifyObservers()
+	}
+
+	return true
+}
+
+func (p *Promise) abort(reason error) bool {
+	if !p.settle(StateRejected, nil, p.wrapError(reason)) {
+		return false
+	}
+
+	p.notifyObservers()
+
+	return true
+}
+
+func (p *Promise) reportSettled() {
+	if nil != p.tracer {
+		p.tracer.PromiseSettled(p)
+	}
+
+	if nil != p.metrics {
+		p.mutex.RLock()
+		name, state := p.name, p.state
+		p.mutex.RUnlock()
+
+		p.metrics.PromiseSettled(name, state, p.now().Sub(p.createdAt))
+	}
+
+	p.watchUnhandledRejection()
+}
+
+func (p *Promise) wrapError(err error) error {
+	p.mutex.RLock()
+	name := p.name
+	p.mutex.RUnlock()
+
+	if "" == name {
+		return err
+	}
+
+	return fmt.Errorf("promise %q: %w", name, err)
+}
+
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+func isErrorTarget(target interface{}) bool {
+	if nil == target {
+		return false
+	}
+
+	targetValue := reflect.ValueOf(target)
+	if reflect.Ptr != targetValue.Kind() || targetValue.IsNil() {
+		return false
+	}
+
+	targetType := targetValue.Type().Elem()
+
+	return reflect.Interface == targetType.Kind() || targetType.Implements(errorType)
 }
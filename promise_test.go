@@ -3,6 +3,7 @@ package promise
 import (
 	"errors"
 	"fmt"
+	"runtime"
 	"testing"
 	"time"
 
@@ -46,11 +47,126 @@ func TestResolve(t *testing.T) {
 		require.Equal(t, value, promise.value)
 		require.Nil(t, promise.err)
 	})
+
+	t.Run("Resolved promise adopts state of resolved promise", func(t *testing.T) {
+		value := fakerInstance.Int()
+		promise := Resolve(Resolve(value))
+
+		require.True(t, assertPromise(t, promise, StateFulfilled, value, nil))
+	})
+
+	t.Run("Resolved promise adopts state of rejected promise", func(t *testing.T) {
+		reason := errors.New("error reason")
+		promise := Resolve(Reject(reason))
+
+		require.True(t, assertPromise(t, promise, StateRejected, nil, reason))
+	})
+
+	t.Run("Resolved promise adopts state of pending promise", func(t *testing.T) {
+		value := fakerInstance.Int()
+		pending := Pending()
+		promise := Resolve(pending)
+
+		require.True(t, assertPromise(t, promise, StatePending, nil, nil))
+
+		require.NoError(t, pending.Resolve(value))
+		require.True(t, assertPromise(t, promise, StateFulfilled, value, nil))
+	})
+
+	t.Run("Resolved promise is fulfilled with nil Promise", func(t *testing.T) {
+		promise := Resolve((*Promise)(nil))
+
+		require.Equal(t, StateFulfilled, promise.State())
+		require.Equal(t, (*Promise)(nil), promise.value)
+	})
 }
 
 /**
  * @depends TestPending
  */
+func TestPromise_Named(t *testing.T) {
+	fakerInstance := faker.New()
+
+	t.Run("Named promise can be created", func(t *testing.T) {
+		name := fakerInstance.Lorem().Word()
+		promise := Pending()
+
+		require.Same(t, promise, promise.Named(name))
+		require.Equal(t, name, promise.Name())
+	})
+
+	t.Run("Derived promises inherit name with handler suffix", func(t *testing.T) {
+		name := fakerInstance.Lorem().Word()
+		promise := Pending().Named(name)
+
+		thenPromise := promise.Then(func(value interface{}) (interface{}, error) {
+			return value, nil
+		})
+		finallyPromise := thenPromise.Catch(func(_ error) {}).Finally(func() {})
+
+		require.Equal(t, name+".then", thenPromise.(*Promise).Name())
+		require.Equal(t, name+".then.catch.finally", finallyPromise.(*Promise).Name())
+		require.Empty(t, Pending().Then(func(value interface{}) (interface{}, error) {
+			return value, nil
+		}).(*Promise).Name())
+	})
+
+	t.Run("Errors contain promise name", func(t *testing.T) {
+		name := fakerInstance.Lorem().Word()
+		promise := Resolve(fakerInstance.Int()).Named(name)
+
+		resolveErr := promise.Resolve(fakerInstance.Int())
+		rejectErr := promise.Reject(errors.New(fakerInstance.Lorem().Sentence(6)))
+
+		require.ErrorIs(t, resolveErr, ErrResolveNotPendingPromise)
+		require.EqualError(t, resolveErr, fmt.Sprintf("promise %q: %s", name, ErrResolveNotPendingPromise))
+		require.ErrorIs(t, rejectErr, ErrRejectNotPendingPromise)
+		require.EqualError(t, rejectErr, fmt.Sprintf("promise %q: %s", name, ErrRejectNotPendingPromise))
+	})
+
+	t.Run("Chaining cycle rejection reason contains promise name", func(t *testing.T) {
+		name := fakerInstance.Lorem().Word()
+		promise := Pending().Named(name)
+
+		require.NoError(t, promise.Resolve(promise))
+		require.ErrorIs(t, promise.err, ErrChainingCycle)
+		require.EqualError(t, promise.err, fmt.Sprintf("promise %q: %s", name, ErrChainingCycle))
+	})
+}
+
+func TestPromise_Label(t *testing.T) {
+	fakerInstance := faker.New()
+
+	t.Run("Labels can be attached to promise", func(t *testing.T) {
+		promise := Pending()
+
+		require.Same(t, promise, promise.Label("key-1", "value-1").Label("key-2", "value-2"))
+		require.Equal(t, map[string]string{"key-1": "value-1", "key-2": "value-2"}, promise.Labels())
+	})
+
+	t.Run("Derived promises inherit labels", func(t *testing.T) {
+		promise := Pending().Label("key-1", "value-1")
+
+		thenPromise := promise.Then(func(value interface{}) (interface{}, error) {
+			return value, nil
+		}).(*Promise)
+
+		thenPromise.Label("key-2", fakerInstance.Lorem().Word())
+
+		require.Equal(t, map[string]string{"key-1": "value-1"}, promise.Labels())
+		require.Len(t, thenPromise.Labels(), 2)
+		require.Equal(t, "value-1", thenPromise.Labels()["key-1"])
+	})
+
+	t.Run("Returned labels are a copy", func(t *testing.T) {
+		promise := Pending().Label("key-1", "value-1")
+
+		promise.Labels()["key-1"] = fakerInstance.Lorem().Word()
+
+		require.Equal(t, map[string]string{"key-1": "value-1"}, promise.Labels())
+	})
+}
+
 func TestPromise_Resolve(t *testing.T) {
 	fakerInstance := faker.New()
 
@@ -88,6 +204,67 @@ func TestPromise_Resolve(t *testing.T) {
 		callsStack.AssertCompletedInOrderBefore(t, []string{"Fulfilled"}, time.Millisecond*100)
 		require.True(t, assertPromise(t, &promise, StateFulfilled, resolutionValue, nil))
 	})
+
+	t.Run("Fulfills with nil Promise it is resolved with", func(t *testing.T) {
+		promise := Pending()
+
+		require.NoError(t, promise.Resolve((*Promise)(nil)))
+		require.Equal(t, StateFulfilled, promise.State())
+		require.Equal(t, (*Promise)(nil), promise.value)
+	})
+
+	t.Run("Adopts state of Promise it is resolved with", func(t *testing.T) {
+		callsStack := newCallsRegistry(1)
+
+		var resolutionValue = fakerInstance.Int()
+
+		adopted := Pending()
+		promise := Pending()
+
+		promise.Then(func(value interface{}) (interface{}, error) {
+			require.Equal(t, resolutionValue, value)
+
+			callsStack.Register("Then")
+
+			return nil, nil
+		})
+
+		require.NoError(t, promise.Resolve(adopted))
+		require.True(t, assertPromise(t, promise, StatePending, nil, nil))
+		require.ErrorIs(t, promise.Resolve(fakerInstance.Int()), ErrResolveNotPendingPromise)
+		require.ErrorIs(t, promise.Reject(errors.New("some error")), ErrRejectNotPendingPromise)
+
+		require.NoError(t, adopted.Resolve(resolutionValue))
+		callsStack.AssertCompletedInOrderBefore(t, []string{"Then"}, time.Millisecond*100)
+		require.True(t, assertPromise(t, promise, StateFulfilled, resolutionValue, nil))
+	})
+
+	t.Run("Adopts rejection of Promise it is resolved with", func(t *testing.T) {
+		var rejectionReason = errors.New(fakerInstance.Lorem().Sentence(6))
+
+		promise := Pending()
+
+		require.NoError(t, promise.Resolve(Reject(rejectionReason)))
+		require.True(t, assertPromise(t, promise, StateRejected, nil, rejectionReason))
+	})
+
+	t.Run("Rejects when resolved with itself", func(t *testing.T) {
+		promise := Pending()
+
+		require.NoError(t, promise.Resolve(promise))
+		require.True(t, assertPromise(t, promise, StateRejected, nil, ErrChainingCycle))
+	})
+
+	t.Run("Rejects when resolved with a cycle of promises", func(t *testing.T) {
+		first := Pending()
+		second := Pending()
+
+		require.NoError(t, first.Resolve(second))
+		require.NoError(t, second.Resolve(first))
+
+		require.True(t, assertPromise(t, second, StateRejected, nil, ErrChainingCycle))
+		require.True(t, assertPromise(t, first, StateRejected, nil, ErrChainingCycle))
+	})
 }
 
 /**
@@ -181,133 +358,802 @@ func TestPromise_Then(t *testing.T) {
 		callsStack.AssertCompletedInOrderBefore(t, []string{"Then"}, time.Millisecond*100)
 	})
 
-	t.Run(fmt.Sprintf("Returns new Promise, does not register handler and skips Then for Promise in state: %s", StateRejected), func(t *testing.T) {
-		callsStack := newCallsRegistry(0)
+	t.Run(fmt.Sprintf("Returns new Promise, does not register handler and skips Then for Promise in state: %s", StateRejected), func(t *testing.T) {
+		callsStack := newCallsRegistry(0)
+
+		promise := Promise{
+			state: StateRejected,
+		}
+
+		thenPromise := promise.Then(func(value interface{}) (interface{}, error) {
+			callsStack.Register("Then")
+
+			return nil, nil
+		})
+
+		require.NotSame(t, &promise, thenPromise)
+		require.Empty(t, promise.handlers)
+		callsStack.AssertCompletedCallsStackIsEmpty(t)
+	})
+}
+
+func TestPromise_ThenCatch(t *testing.T) {
+	fakerInstance := faker.New()
+
+	t.Run("Executes fulfill handler for resolved Promise", func(t *testing.T) {
+		callsStack := newCallsRegistry(2)
+
+		var resolvedValue = fakerInstance.Int()
+
+		promise := Promise{
+			state: StateFulfilled,
+			value: resolvedValue,
+		}
+
+		promise.
+			ThenCatch(
+				func(value interface{}) (interface{}, error) {
+					require.Equal(t, resolvedValue, value)
+
+					callsStack.Register("ThenCatch.onFulfilled")
+
+					return resolvedValue + 1, nil
+				},
+				func(_ error) (interface{}, error) {
+					callsStack.Register("ThenCatch.onRejected")
+
+					return nil, nil
+				},
+			).
+			Then(func(value interface{}) (interface{}, error) {
+				require.Equal(t, resolvedValue+1, value)
+
+				callsStack.Register("Then")
+
+				return nil, nil
+			})
+
+		callsStack.AssertCompletedInOrderBefore(t, []string{"ThenCatch.onFulfilled", "Then"}, time.Millisecond*100)
+	})
+
+	t.Run("Executes reject handler for rejected Promise and resolves with its result", func(t *testing.T) {
+		callsStack := newCallsRegistry(2)
+
+		var rejectionReason = errors.New(fakerInstance.Lorem().Sentence(6))
+		var recoveryValue = fakerInstance.Int()
+
+		promise := Promise{
+			state: StateRejected,
+			err:   rejectionReason,
+		}
+
+		promise.
+			ThenCatch(
+				func(_ interface{}) (interface{}, error) {
+					callsStack.Register("ThenCatch.onFulfilled")
+
+					return nil, nil
+				},
+				func(reason error) (interface{}, error) {
+					require.Same(t, rejectionReason, reason)
+
+					callsStack.Register("ThenCatch.onRejected")
+
+					return Resolve(recoveryValue), nil
+				},
+			).
+			Then(func(value interface{}) (interface{}, error) {
+				require.Equal(t, recoveryValue, value)
+
+				callsStack.Register("Then")
+
+				return nil, nil
+			})
+
+		callsStack.AssertCompletedInOrderBefore(t, []string{"ThenCatch.onRejected", "Then"}, time.Millisecond*100)
+	})
+
+	t.Run("Does not pass fulfill handler error to reject handler", func(t *testing.T) {
+		callsStack := newCallsRegistry(2)
+
+		var handlerReason = errors.New(fakerInstance.Lorem().Sentence(6))
+
+		promise := Promise{
+			state: StateFulfilled,
+			value: fakerInstance.Int(),
+		}
+
+		promise.
+			ThenCatch(
+				func(_ interface{}) (interface{}, error) {
+					callsStack.Register("ThenCatch.onFulfilled")
+
+					return nil, handlerReason
+				},
+				func(_ error) (interface{}, error) {
+					callsStack.Register("ThenCatch.onRejected")
+
+					return nil, nil
+				},
+			).
+			Catch(func(reason error) {
+				require.Same(t, handlerReason, reason)
+
+				callsStack.Register("Catch")
+			})
+
+		callsStack.AssertCompletedInOrderBefore(t, []string{"ThenCatch.onFulfilled", "Catch"}, time.Millisecond*100)
+	})
+
+	for _, tt := range []struct {
+		state State
+	}{
+		{state: StateFulfilled},
+		{state: StateRejected},
+	} {
+		t.Run(fmt.Sprintf("Passes outcome through when matching handler is nil for Promise in state: %s", tt.state), func(t *testing.T) {
+			callsStack := newCallsRegistry(1)
+
+			var resolvedValue = fakerInstance.Int()
+			var rejectionReason = errors.New(fakerInstance.Lorem().Sentence(6))
+
+			promise := Promise{
+				state: tt.state,
+			}
+
+			if StateFulfilled == tt.state {
+				promise.value = resolvedValue
+			} else {
+				promise.err = rejectionReason
+			}
+
+			promise.
+				ThenCatch(nil, nil).
+				ThenCatch(
+					func(value interface{}) (interface{}, error) {
+						require.Equal(t, resolvedValue, value)
+
+						callsStack.Register("ThenCatch")
+
+						return nil, nil
+					},
+					func(reason error) (interface{}, error) {
+						require.Same(t, rejectionReason, reason)
+
+						callsStack.Register("ThenCatch")
+
+						return nil, nil
+					},
+				)
+
+			callsStack.AssertCompletedInOrderBefore(t, []string{"ThenCatch"}, time.Millisecond*100)
+		})
+	}
+}
+
+func TestPromise_Catch(t *testing.T) {
+	fakerInstance := faker.New()
+
+	for _, tt := range []struct {
+		state State
+	}{
+		{state: StatePending},
+		{state: StateSettling},
+	} {
+		t.Run(fmt.Sprintf("Returns new Promise and registers handler for Promise in state: %s", tt.state), func(t *testing.T) {
+			callsStack := newCallsRegistry(0)
+
+			promise := Promise{
+				state: tt.state,
+			}
+
+			catchPromise := promise.Catch(func(reason error) {
+				callsStack.Register("Catch")
+			})
+
+			require.NotSame(t, &promise, catchPromise)
+			require.Len(t, promise.handlers, 1)
+			callsStack.AssertCompletedCallsStackIsEmpty(t)
+		})
+	}
+
+	t.Run(fmt.Sprintf("Returns new Promise, does not register handler and skips Catch for Promise in state: %s", StateFulfilled), func(t *testing.T) {
+		callsStack := newCallsRegistry(0)
+
+		promise := Promise{
+			state: StateFulfilled,
+		}
+
+		catchPromise := promise.Catch(func(reason error) {
+			callsStack.Register("Catch")
+		})
+
+		require.NotSame(t, &promise, catchPromise)
+		require.Empty(t, promise.handlers)
+		callsStack.AssertCompletedCallsStackIsEmpty(t)
+	})
+
+	t.Run(fmt.Sprintf("Returns new Promise, does not register handler and executes Catch immidiately for Promise in state: %s", StateRejected), func(t *testing.T) {
+		callsStack := newCallsRegistry(1)
+
+		promise := Promise{
+			state: StateRejected,
+			err:   errors.New(fakerInstance.Lorem().Sentence(6)),
+		}
+
+		catchPromise := promise.Catch(func(reason error) {
+			require.ErrorIs(t, promise.err, reason)
+
+			callsStack.Register("Catch")
+		})
+
+		require.NotSame(t, &promise, catchPromise)
+		require.Empty(t, promise.handlers)
+		callsStack.AssertCompletedInOrderBefore(t, []string{"Catch"}, time.Millisecond*100)
+	})
+}
+
+func TestPromise_CatchIs(t *testing.T) {
+	fakerInstance := faker.New()
+
+	t.Run("Executes handler when rejection reason matches target", func(t *testing.T) {
+		callsStack := newCallsRegistry(2)
+
+		var targetReason = errors.New(fakerInstance.Lorem().Sentence(6))
+		var rejectionReason = fmt.Errorf("wrapped: %w", targetReason)
+
+		promise := Promise{
+			state: StateRejected,
+			err:   rejectionReason,
+		}
+
+		promise.
+			CatchIs(targetReason, func(reason error) {
+				require.Same(t, rejectionReason, reason)
+
+				callsStack.Register("CatchIs")
+			}).
+			Then(func(value interface{}) (interface{}, error) {
+				require.Nil(t, value)
+
+				callsStack.Register("Then")
+
+				return nil, nil
+			})
+
+		callsStack.AssertCompletedInOrderBefore(t, []string{"CatchIs", "Then"}, time.Millisecond*100)
+	})
+
+	t.Run("Passes not matching rejection reason through", func(t *testing.T) {
+		callsStack := newCallsRegistry(1)
+
+		var rejectionReason = errors.New(fakerInstance.Lorem().Sentence(6))
+
+		promise := Promise{
+			state: StateRejected,
+			err:   rejectionReason,
+		}
+
+		promise.
+			CatchIs(errors.New(fakerInstance.Lorem().Sentence(6)), func(_ error) {
+				callsStack.Register("CatchIs")
+			}).
+			Catch(func(reason error) {
+				require.Same(t, rejectionReason, reason)
+
+				callsStack.Register("Catch")
+			})
+
+		callsStack.AssertCompletedInOrderBefore(t, []string{"Catch"}, time.Millisecond*100)
+	})
+
+	t.Run("Passes resolution value through", func(t *testing.T) {
+		callsStack := newCallsRegistry(1)
+
+		var resolvedValue = fakerInstance.Int()
+
+		promise := Promise{
+			state: StateFulfilled,
+			value: resolvedValue,
+		}
+
+		promise.
+			CatchIs(errors.New(fakerInstance.Lorem().Sentence(6)), func(_ error) {
+				callsStack.Register("CatchIs")
+			}).
+			Then(func(value interface{}) (interface{}, error) {
+				require.Equal(t, resolvedValue, value)
+
+				callsStack.Register("Then")
+
+				return nil, nil
+			})
+
+		callsStack.AssertCompletedInOrderBefore(t, []string{"Then"}, time.Millisecond*100)
+	})
+}
+
+type catchAsTestError struct {
+	message string
+}
+
+func (e *catchAsTestError) Error() string {
+	return e.message
+}
+
+func TestPromise_CatchAs(t *testing.T) {
+	fakerInstance := faker.New()
+
+	t.Run("Executes handler and populates target when rejection reason matches it", func(t *testing.T) {
+		callsStack := newCallsRegistry(1)
+
+		var rejectionReason = &catchAsTestError{message: fakerInstance.Lorem().Sentence(6)}
+		var target *catchAsTestError
+
+		promise := Promise{
+			state: StateRejected,
+			err:   fmt.Errorf("wrapped: %w", rejectionReason),
+		}
+
+		promise.CatchAs(&target, func(_ error) {
+			require.Same(t, rejectionReason, target)
+
+			callsStack.Register("CatchAs")
+		})
+
+		callsStack.AssertCompletedInOrderBefore(t, []string{"CatchAs"}, time.Millisecond*100)
+	})
+
+	t.Run("Passes not matching rejection reason through", func(t *testing.T) {
+		callsStack := newCallsRegistry(1)
+
+		var rejectionReason = errors.New(fakerInstance.Lorem().Sentence(6))
+		var target *catchAsTestError
+
+		promise := Promise{
+			state: StateRejected,
+			err:   rejectionReason,
+		}
+
+		promise.
+			CatchAs(&target, func(_ error) {
+				callsStack.Register("CatchAs")
+			}).
+			Catch(func(reason error) {
+				require.Same(t, rejectionReason, reason)
+				require.Nil(t, target)
+
+				callsStack.Register("Catch")
+			})
+
+		callsStack.AssertCompletedInOrderBefore(t, []string{"Catch"}, time.Millisecond*100)
+	})
+
+	for _, tt := range []struct {
+		name   string
+		target interface{}
+	}{
+		{name: "nil", target: nil},
+		{name: "not a pointer", target: catchAsTestError{}},
+		{name: "nil pointer", target: (*error)(nil)},
+		{name: "pointer to non-error type", target: new(string)},
+	} {
+		t.Run(fmt.Sprintf("Panics for invalid target: %s", tt.name), func(t *testing.T) {
+			promise := Promise{
+				state: StatePending,
+			}
+
+			require.PanicsWithValue(t, ErrInvalidCatchAsTarget, func() {
+				promise.CatchAs(tt.target, func(_ error) {})
+			})
+			require.Empty(t, promise.handlers)
+		})
+	}
+}
+
+func TestPromise_CatchAsync(t *testing.T) {
+	fakerInstance := faker.New()
+
+	t.Run("Waits for returned Promise and resolves with its value", func(t *testing.T) {
+		callsStack := newCallsRegistry(2)
+
+		var rejectionReason = errors.New(fakerInstance.Lorem().Sentence(6))
+		var recoveryValue = fakerInstance.Int()
+
+		recovery := Pending()
+
+		promise := Promise{
+			state: StateRejected,
+			err:   rejectionReason,
+		}
+
+		promise.
+			CatchAsync(func(reason error) Promiser {
+				require.Same(t, rejectionReason, reason)
+
+				callsStack.Register("CatchAsync")
+
+				return recovery
+			}).
+			Then(func(value interface{}) (interface{}, error) {
+				require.Equal(t, recoveryValue, value)
+
+				callsStack.Register("Then")
+
+				return nil, nil
+			})
+
+		time.Sleep(time.Millisecond * 50)
+		callsStack.AssertCurrentCallsStackInOrderIs(t, []string{"CatchAsync"})
+		callsStack.AssertThereAreNCallsLeft(t, 1)
+
+		require.NoError(t, recovery.Resolve(recoveryValue))
+
+		callsStack.AssertCompletedInOrderBefore(t, []string{"CatchAsync", "Then"}, time.Millisecond*100)
+	})
+
+	t.Run("Rejects with returned Promise rejection reason", func(t *testing.T) {
+		callsStack := newCallsRegistry(2)
+
+		var recoveryReason = errors.New(fakerInstance.Lorem().Sentence(6))
+
+		promise := Promise{
+			state: StateRejected,
+			err:   errors.New(fakerInstance.Lorem().Sentence(6)),
+		}
+
+		promise.
+			CatchAsync(func(_ error) Promiser {
+				callsStack.Register("CatchAsync")
+
+				return Reject(recoveryReason)
+			}).
+			Catch(func(reason error) {
+				require.Same(t, recoveryReason, reason)
+
+				callsStack.Register("Catch")
+			})
+
+		callsStack.AssertCompletedInOrderBefore(t, []string{"CatchAsync", "Catch"}, time.Millisecond*100)
+	})
+
+	t.Run("Resolves with nil when handler returns no Promise", func(t *testing.T) {
+		callsStack := newCallsRegistry(2)
+
+		promise := Promise{
+			state: StateRejected,
+			err:   errors.New(fakerInstance.Lorem().Sentence(6)),
+		}
+
+		promise.
+			CatchAsync(func(_ error) Promiser {
+				callsStack.Register("CatchAsync")
+
+				return nil
+			}).
+			Then(func(value interface{}) (interface{}, error) {
+				require.Nil(t, value)
+
+				callsStack.Register("Then")
+
+				return nil, nil
+			})
+
+		callsStack.AssertCompletedInOrderBefore(t, []string{"CatchAsync", "Then"}, time.Millisecond*100)
+	})
+
+	t.Run("Skips handler and passes resolution value through", func(t *testing.T) {
+		callsStack := newCallsRegistry(1)
+
+		var resolvedValue = fakerInstance.Int()
+
+		promise := Promise{
+			state: StateFulfilled,
+			value: resolvedValue,
+		}
+
+		promise.
+			CatchAsync(func(_ error) Promiser {
+				callsStack.Register("CatchAsync")
+
+				return nil
+			}).
+			Then(func(value interface{}) (interface{}, error) {
+				require.Equal(t, resolvedValue, value)
+
+				callsStack.Register("Then")
+
+				return nil, nil
+			})
+
+		callsStack.AssertCompletedInOrderBefore(t, []string{"Then"}, time.Millisecond*100)
+	})
+}
+
+func TestPromise_Finally(t *testing.T) {
+	for _, tt := range []struct {
+		state State
+	}{
+		{state: StatePending},
+		{state: StateSettling},
+	} {
+		t.Run(fmt.Sprintf("Returns new Promise and registers handler for Promise in state: %s", tt.state), func(t *testing.T) {
+			callsStack := newCallsRegistry(0)
+
+			promise := Promise{
+				state: tt.state,
+			}
+
+			finallyPromise := promise.Finally(func() {
+				callsStack.Register("Finally")
+			})
+
+			require.NotSame(t, &promise, finallyPromise)
+			require.Len(t, promise.handlers, 1)
+			callsStack.AssertCompletedCallsStackIsEmpty(t)
+		})
+	}
+
+	for _, tt := range []struct {
+		state State
+	}{
+		{state: StateFulfilled},
+		{state: StateRejected},
+	} {
+		t.Run(fmt.Sprintf("Returns new Promise, does not register handler and executes Finally immidiately for Promise in state: %s", tt.state), func(t *testing.T) {
+			callsStack := newCallsRegistry(1)
+
+			promise := Promise{
+				state: tt.state,
+			}
+
+			finallyPromise := promise.Finally(func() {
+				callsStack.Register("Finally")
+			})
+
+			require.NotSame(t, &promise, finallyPromise)
+			require.Empty(t, promise.handlers)
+			callsStack.AssertCompletedInOrderBefore(t, []string{"Finally"}, time.Millisecond*100)
+		})
+	}
+}
+
+func TestPromise_FinallyAsync(t *testing.T) {
+	fakerInstance := faker.New()
+
+	t.Run("Waits for returned Promise and passes resolution value through", func(t *testing.T) {
+		callsStack := newCallsRegistry(2)
+
+		var resolvedValue = fakerInstance.Int()
+
+		cleanup := Pending()
+
+		promise := Promise{
+			state: StateFulfilled,
+			value: resolvedValue,
+		}
+
+		promise.
+			FinallyAsync(func() Promiser {
+				callsStack.Register("FinallyAsync")
+
+				return cleanup
+			}).
+			Then(func(value interface{}) (interface{}, error) {
+				require.Equal(t, resolvedValue, value)
+
+				callsStack.Register("Then")
+
+				return nil, nil
+			})
+
+		time.Sleep(time.Millisecond * 50)
+		callsStack.AssertCurrentCallsStackInOrderIs(t, []string{"FinallyAsync"})
+		callsStack.AssertThereAreNCallsLeft(t, 1)
+
+		require.NoError(t, cleanup.Resolve(fakerInstance.Int()))
+
+		callsStack.AssertCompletedInOrderBefore(t, []string{"FinallyAsync", "Then"}, time.Millisecond*100)
+	})
+
+	t.Run("Waits for returned Promise and passes rejection reason through", func(t *testing.T) {
+		callsStack := newCallsRegistry(2)
+
+		var rejectionReason = errors.New(fakerInstance.Lorem().Sentence(6))
 
 		promise := Promise{
 			state: StateRejected,
+			err:   rejectionReason,
 		}
 
-		thenPromise := promise.Then(func(value interface{}) (interface{}, error) {
-			callsStack.Register("Then")
+		promise.
+			FinallyAsync(func() Promiser {
+				callsStack.Register("FinallyAsync")
 
-			return nil, nil
-		})
+				return Resolve(fakerInstance.Int())
+			}).
+			Catch(func(reason error) {
+				require.Same(t, rejectionReason, reason)
 
-		require.NotSame(t, &promise, thenPromise)
-		require.Empty(t, promise.handlers)
-		callsStack.AssertCompletedCallsStackIsEmpty(t)
-	})
-}
+				callsStack.Register("Catch")
+			})
 
-func TestPromise_Catch(t *testing.T) {
-	fakerInstance := faker.New()
+		callsStack.AssertCompletedInOrderBefore(t, []string{"FinallyAsync", "Catch"}, time.Millisecond*100)
+	})
 
 	for _, tt := range []struct {
 		state State
 	}{
-		{state: StatePending},
-		{state: StateSettling},
+		{state: StateFulfilled},
+		{state: StateRejected},
 	} {
-		t.Run(fmt.Sprintf("Returns new Promise and registers handler for Promise in state: %s", tt.state), func(t *testing.T) {
-			callsStack := newCallsRegistry(0)
+		t.Run(fmt.Sprintf("Rejects with returned Promise rejection reason for Promise in state: %s", tt.state), func(t *testing.T) {
+			callsStack := newCallsRegistry(2)
+
+			var cleanupReason = errors.New(fakerInstance.Lorem().Sentence(6))
 
 			promise := Promise{
 				state: tt.state,
+				err:   errors.New(fakerInstance.Lorem().Sentence(6)),
 			}
 
-			catchPromise := promise.Catch(func(reason error) {
-				callsStack.Register("Catch")
-			})
+			promise.
+				FinallyAsync(func() Promiser {
+					callsStack.Register("FinallyAsync")
 
-			require.NotSame(t, &promise, catchPromise)
-			require.Len(t, promise.handlers, 1)
-			callsStack.AssertCompletedCallsStackIsEmpty(t)
+					return Reject(cleanupReason)
+				}).
+				Catch(func(reason error) {
+					require.Same(t, cleanupReason, reason)
+
+					callsStack.Register("Catch")
+				})
+
+			callsStack.AssertCompletedInOrderBefore(t, []string{"FinallyAsync", "Catch"}, time.Millisecond*100)
 		})
 	}
 
-	t.Run(fmt.Sprintf("Returns new Promise, does not register handler and skips Catch for Promise in state: %s", StateFulfilled), func(t *testing.T) {
-		callsStack := newCallsRegistry(0)
+	t.Run("Does not leave unhandled rejections behind when returned Promise is rejected", func(t *testing.T) {
+		callsStack := newCallsRegistry(1)
+		reports := make(chan error, 10)
+
+		var cleanupReason = errors.New(fakerInstance.Lorem().Sentence(6))
+
+		rt := NewRuntime(WithUnhandledRejectionHandler(func(_ *Promise, reason error) {
+			reports <- reason
+		}))
+
+		rt.Resolve(fakerInstance.Int()).
+			FinallyAsync(func() Promiser {
+				return rt.Reject(cleanupReason)
+			}).
+			Catch(func(reason error) {
+				require.Same(t, cleanupReason, reason)
+
+				callsStack.Register("Catch")
+			})
+
+		callsStack.AssertCompletedInOrderBefore(t, []string{"Catch"}, time.Millisecond*100)
+
+		for i := 0; i < 10; i++ {
+			runtime.GC()
+
+			select {
+			case reason := <-reports:
+				require.Failf(t, "Unhandled rejection reported", "%v", reason)
+
+			case <-time.After(10 * time.Millisecond):
+			}
+		}
+	})
+}
+
+func TestPromise_Tap(t *testing.T) {
+	fakerInstance := faker.New()
+
+	t.Run("Observes resolution value and passes it through", func(t *testing.T) {
+		callsStack := newCallsRegistry(2)
+
+		var resolvedValue = fakerInstance.Int()
 
 		promise := Promise{
 			state: StateFulfilled,
+			value: resolvedValue,
 		}
 
-		catchPromise := promise.Catch(func(reason error) {
-			callsStack.Register("Catch")
-		})
+		promise.
+			Tap(func(value interface{}) {
+				require.Equal(t, resolvedValue, value)
 
-		require.NotSame(t, &promise, catchPromise)
-		require.Empty(t, promise.handlers)
-		callsStack.AssertCompletedCallsStackIsEmpty(t)
+				callsStack.Register("Tap")
+			}).
+			Then(func(value interface{}) (interface{}, error) {
+				require.Equal(t, resolvedValue, value)
+
+				callsStack.Register("Then")
+
+				return nil, nil
+			})
+
+		callsStack.AssertCompletedInOrderBefore(t, []string{"Tap", "Then"}, time.Millisecond*100)
 	})
 
-	t.Run(fmt.Sprintf("Returns new Promise, does not register handler and executes Catch immidiately for Promise in state: %s", StateRejected), func(t *testing.T) {
+	t.Run("Skips handler and passes rejection reason through", func(t *testing.T) {
 		callsStack := newCallsRegistry(1)
 
+		var rejectionReason = errors.New(fakerInstance.Lorem().Sentence(6))
+
 		promise := Promise{
 			state: StateRejected,
-			err:   errors.New(fakerInstance.Lorem().Sentence(6)),
+			err:   rejectionReason,
 		}
 
-		catchPromise := promise.Catch(func(reason error) {
-			require.ErrorIs(t, promise.err, reason)
+		promise.
+			Tap(func(_ interface{}) {
+				callsStack.Register("Tap")
+			}).
+			Catch(func(reason error) {
+				require.Same(t, rejectionReason, reason)
 
-			callsStack.Register("Catch")
-		})
+				callsStack.Register("Catch")
+			})
 
-		require.NotSame(t, &promise, catchPromise)
-		require.Empty(t, promise.handlers)
 		callsStack.AssertCompletedInOrderBefore(t, []string{"Catch"}, time.Millisecond*100)
 	})
 }
 
-func TestPromise_Finally(t *testing.T) {
-	for _, tt := range []struct {
-		state State
-	}{
-		{state: StatePending},
-		{state: StateSettling},
-	} {
-		t.Run(fmt.Sprintf("Returns new Promise and registers handler for Promise in state: %s", tt.state), func(t *testing.T) {
-			callsStack := newCallsRegistry(0)
+func TestPromise_TapError(t *testing.T) {
+	fakerInstance := faker.New()
 
-			promise := Promise{
-				state: tt.state,
-			}
+	t.Run("Observes rejection reason and passes it through", func(t *testing.T) {
+		callsStack := newCallsRegistry(2)
 
-			finallyPromise := promise.Finally(func() {
-				callsStack.Register("Finally")
+		var rejectionReason = errors.New(fakerInstance.Lorem().Sentence(6))
+
+		promise := Promise{
+			state: StateRejected,
+			err:   rejectionReason,
+		}
+
+		promise.
+			TapError(func(reason error) {
+				require.Same(t, rejectionReason, reason)
+
+				callsStack.Register("TapError")
+			}).
+			Catch(func(reason error) {
+				require.Same(t, rejectionReason, reason)
+
+				callsStack.Register("Catch")
 			})
 
-			require.NotSame(t, &promise, finallyPromise)
-			require.Len(t, promise.handlers, 1)
-			callsStack.AssertCompletedCallsStackIsEmpty(t)
-		})
-	}
+		callsStack.AssertCompletedInOrderBefore(t, []string{"TapError", "Catch"}, time.Millisecond*100)
+	})
 
-	for _, tt := range []struct {
-		state State
-	}{
-		{state: StateFulfilled},
-		{state: StateRejected},
-	} {
-		t.Run(fmt.Sprintf("Returns new Promise, does not register handler and executes Finally immidiately for Promise in state: %s", tt.state), func(t *testing.T) {
-			callsStack := newCallsRegistry(1)
+	t.Run("Skips handler and passes resolution value through", func(t *testing.T) {
+		callsStack := newCallsRegistry(1)
 
-			promise := Promise{
-				state: tt.state,
-			}
+		var resolvedValue = fakerInstance.Int()
 
-			finallyPromise := promise.Finally(func() {
-				callsStack.Register("Finally")
+		promise := Promise{
+			state: StateFulfilled,
+			value: resolvedValue,
+		}
+
+		promise.
+			TapError(func(_ error) {
+				callsStack.Register("TapError")
+			}).
+			Then(func(value interface{}) (interface{}, error) {
+				require.Equal(t, resolvedValue, value)
+
+				callsStack.Register("Then")
+
+				return nil, nil
 			})
 
-			require.NotSame(t, &promise, finallyPromise)
-			require.Empty(t, promise.handlers)
-			callsStack.AssertCompletedInOrderBefore(t, []string{"Finally"}, time.Millisecond*100)
-		})
-	}
+		callsStack.AssertCompletedInOrderBefore(t, []string{"Then"}, time.Millisecond*100)
+	})
 }
 
 func TestNewPromise(t *testing.T) {
@@ -462,6 +1308,70 @@ func TestNewPromise(t *testing.T) {
 		time.Sleep(time.Millisecond * 50)
 		require.True(t, assertPromise(t, promise, StateRejected, nil, rejectionReason))
 	})
+
+	t.Run("Resolved with Promise adopts its state", func(t *testing.T) {
+		waitGroup := newWaitGroup()
+
+		var resolvedValue = fakerInstance.Int()
+
+		adopted := Pending()
+
+		waitGroup.Initialize("NewPromise", 1)
+
+		promise := NewPromise(func(resolve Resolver, reject Rejector) {
+			defer waitGroup.Done("NewPromise")
+
+			resolve(adopted)
+			resolve(fakerInstance.Int())
+			reject(errors.New(fakerInstance.Lorem().Sentence(6)))
+		})
+
+		waitGroup.Wait("NewPromise")
+		time.Sleep(time.Millisecond * 50)
+		require.Equal(t, StatePending, promise.State())
+
+		require.NoError(t, adopted.Resolve(resolvedValue))
+		require.Equal(t, StateFulfilled, promise.State())
+		require.Equal(t, resolvedValue, promise.value)
+	})
+
+	t.Run("Resolved with itself is rejected", func(t *testing.T) {
+		waitGroup := newWaitGroup()
+
+		waitGroup.Initialize("root", 1)
+
+		var promise *Promise
+
+		promise = NewPromise(func(resolve Resolver, _ Rejector) {
+			waitGroup.Wait("root")
+
+			resolve(promise)
+		})
+
+		waitGroup.Done("root")
+		time.Sleep(time.Millisecond * 50)
+
+		require.Equal(t, StateRejected, promise.State())
+		require.Same(t, ErrChainingCycle, promise.err)
+	})
+
+	t.Run("Resolved with nil Promise is fulfilled with it", func(t *testing.T) {
+		waitGroup := newWaitGroup()
+
+		waitGroup.Initialize("NewPromise", 1)
+
+		promise := NewPromise(func(resolve Resolver, _ Rejector) {
+			defer waitGroup.Done("NewPromise")
+
+			resolve((*Promise)(nil))
+		})
+
+		waitGroup.Wait("NewPromise")
+		time.Sleep(time.Millisecond * 50)
+
+		require.Equal(t, StateFulfilled, promise.State())
+		require.Equal(t, (*Promise)(nil), promise.value)
+	})
 }
 
 func TestPromise(t *testing.T) {
@@ -1229,6 +2139,85 @@ func TestPromise(t *testing.T) {
 	})
 }
 
+func TestPromise_notifyObservers(t *testing.T) {
+	fakerInstance := faker.New()
+
+	t.Run("Handlers can inspect the Promise they are registered on", func(t *testing.T) {
+		callsStack := newCallsRegistry(1)
+
+		promise := Resolve(fakerInstance.Int())
+
+		promise.Then(func(value interface{}) (interface{}, error) {
+			require.Equal(t, StateFulfilled, promise.State())
+
+			callsStack.Register("Then")
+
+			return value, nil
+		})
+
+		callsStack.AssertCompletedInOrderBefore(t, []string{"Then"}, time.Millisecond*100)
+	})
+
+	t.Run("Handlers registered by handlers are called", func(t *testing.T) {
+		callsStack := newCallsRegistry(2)
+
+		promise := Resolve(fakerInstance.Int())
+
+		promise.Then(func(value interface{}) (interface{}, error) {
+			callsStack.Register("Then.1")
+
+			promise.Then(func(value interface{}) (interface{}, error) {
+				callsStack.Register("Then.2")
+
+				return value, nil
+			})
+
+			return value, nil
+		})
+
+		callsStack.AssertCompletedInOrderBefore(t, []string{"Then.1", "Then.2"}, time.Millisecond*100)
+	})
+
+	t.Run("Keeps notifying handlers after a handler panics", func(t *testing.T) {
+		callsStack := newCallsRegistry(3)
+
+		var resolvedValue = fakerInstance.Int()
+
+		promise := Pending()
+
+		first := promise.Then(func(value interface{}) (interface{}, error) {
+			callsStack.Register("Then.1")
+
+			return value, nil
+		})
+
+		promise.Then(func(_ interface{}) (interface{}, error) {
+			panic("boom")
+		})
+
+		promise.Then(func(value interface{}) (interface{}, error) {
+			callsStack.Register("Then.3")
+
+			return value, nil
+		})
+
+		require.PanicsWithValue(t, "boom", func() {
+			_ = promise.Resolve(resolvedValue)
+		})
+
+		callsStack.AssertCurrentCallsStackInOrderIs(t, []string{"Then.1"})
+
+		promise.Then(func(value interface{}) (interface{}, error) {
+			callsStack.Register("Then.4")
+
+			return value, nil
+		})
+
+		callsStack.AssertCompletedInOrderBefore(t, []string{"Then.1", "Then.3", "Then.4"}, time.Millisecond*100)
+		require.True(t, assertPromise(t, first.(*Promise), StateFulfilled, resolvedValue, nil))
+	})
+}
+
 func assertPromise(t *testing.T, promise *Promise, state State, value interface{}, reason error) bool {
 	isSuccessful := assert.Equal(t, state, promise.state)
 
@@ -0,0 +1,93 @@
+package promisedebug
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/donatorsky/go-promise"
+)
+
+var registry = promise.StartTracking()
+
+func init() {
+	http.Handle("/debug/promises", Handler())
+}
+
+func Registry() *promise.Tracker {
+	return registry
+}
+
+func Handler() http.Handler {
+	return NewHandler(registry)
+}
+
+func NewHandler(tracker *promise.Tracker) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		snapshot := tracker.Snapshot()
+
+		if "json" == r.URL.Query().Get("format") {
+			serveJSON(w, snapshot)
+		} else {
+			serveText(w, snapshot)
+		}
+	})
+}
+
+func serveJSON(w http.ResponseWriter, snapshot []promise.PromiseInfo) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	if err := encoder.Encode(snapshot); nil != err {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+func serveText(w http.ResponseWriter, snapshot []promise.PromiseInfo) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+
+	_, _ = fmt.Fprintf(w, "%d live promise(s)\n", len(snapshot))
+
+	for _, info := range snapshot {
+		_, _ = fmt.Fprintf(w, "\n#%d", info.ID)
+
+		if "" != info.Name {
+			_, _ = fmt.Fprintf(w, " %q", info.Name)
+		}
+
+		for _, key := range sortedKeys(info.Labels) {
+			_, _ = fmt.Fprintf(w, " %s=%q", key, info.Labels[key])
+		}
+
+		_, _ = fmt.Fprintf(
+			w,
+			" %s age=%s handlers=%d executor_running=%t\n",
+			info.State,
+			info.Age,
+			info.Handlers,
+			info.ExecutorRunning,
+		)
+
+		if "" != info.CreationStack {
+			_, _ = fmt.Fprintf(w, "\t%s\n", strings.ReplaceAll(strings.TrimSpace(info.CreationStack), "\n", "\n\t"))
+		}
+	}
+}
+
+func sortedKeys(labels map[string]string) []string {
+	keys := make([]string, 0, len(labels))
+
+	for key := range labels {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
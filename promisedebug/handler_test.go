@@ -0,0 +1,70 @@
+package promisedebug
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/donatorsky/go-promise"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler(t *testing.T) {
+	t.Run("Is registered in default serve mux", func(t *testing.T) {
+		handler, pattern := http.DefaultServeMux.Handler(httptest.NewRequest(http.MethodGet, "/debug/promises", nil))
+
+		require.NotNil(t, handler)
+		require.Equal(t, "/debug/promises", pattern)
+	})
+
+	t.Run("Serves text view of live promises", func(t *testing.T) {
+		tracker := promise.StartTracking()
+		defer tracker.Stop()
+
+		p := promise.Pending().Named("fetch-user").Label("user", "42")
+		p.Finally(func() {})
+
+		recorder := httptest.NewRecorder()
+		NewHandler(tracker).ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/debug/promises", nil))
+
+		require.Equal(t, http.StatusOK, recorder.Code)
+		require.Equal(t, "text/plain; charset=utf-8", recorder.Header().Get("Content-Type"))
+		require.Contains(t, recorder.Body.String(), "2 live promise(s)")
+		require.Contains(t, recorder.Body.String(), fmt.Sprintf("#%d \"fetch-user\" user=\"42\" pending age=", p.ID()))
+		require.Contains(t, recorder.Body.String(), fmt.Sprintf("#%d \"fetch-user.finally\" user=\"42\" settling age=", p.ID()+1))
+		require.Contains(t, recorder.Body.String(), "handlers=1 executor_running=false")
+		require.Contains(t, recorder.Body.String(), "promisedebug.TestHandler")
+	})
+
+	t.Run("Serves JSON view of live promises", func(t *testing.T) {
+		tracker := promise.StartTracking()
+		defer tracker.Stop()
+
+		p := promise.Pending().Named("fetch-user")
+
+		recorder := httptest.NewRecorder()
+		NewHandler(tracker).ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/debug/promises?format=json", nil))
+
+		var snapshot []promise.PromiseInfo
+
+		require.Equal(t, http.StatusOK, recorder.Code)
+		require.Equal(t, "application/json", recorder.Header().Get("Content-Type"))
+		require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &snapshot))
+		require.Len(t, snapshot, 1)
+		require.Equal(t, p.ID(), snapshot[0].ID)
+		require.Equal(t, "fetch-user", snapshot[0].Name)
+		require.Equal(t, promise.StatePending, snapshot[0].State)
+	})
+
+	t.Run("Default registry tracks promises", func(t *testing.T) {
+		p := promise.Pending()
+
+		require.Contains(t, Registry().UnsettledPromises(), p)
+
+		require.NoError(t, p.Resolve(nil))
+
+		require.NotContains(t, Registry().UnsettledPromises(), p)
+	})
+}
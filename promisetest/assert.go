@@ -0,0 +1,155 @@
+package promisetest
+
+import (
+	"errors"
+	"time"
+
+	"github.com/donatorsky/go-promise"
+	"github.com/stretchr/testify/assert"
+)
+
+type Outcome struct {
+	State  promise.State
+	Value  interface{}
+	Reason error
+}
+
+func Await(p promise.Promiser, timeout time.Duration) (Outcome, bool) {
+	outcomes := make(chan Outcome, 1)
+
+	p.ThenCatch(
+		func(value interface{}) (interface{}, error) {
+			outcomes <- Outcome{State: promise.StateFulfilled, Value: value}
+
+			return nil, nil
+		},
+		func(reason error) (interface{}, error) {
+			outcomes <- Outcome{State: promise.StateRejected, Reason: reason}
+
+			return nil, nil
+		},
+	)
+
+	select {
+	case outcome := <-outcomes:
+		return outcome, true
+
+	default:
+	}
+
+	select {
+	case outcome := <-outcomes:
+		return outcome, true
+
+	case <-time.After(timeout):
+		return Outcome{State: promise.StatePending}, false
+	}
+}
+
+func AssertSettles(t TestingT, p promise.Promiser, timeout time.Duration) (Outcome, bool) {
+	t.Helper()
+
+	outcome, settled := Await(p, timeout)
+	if !settled {
+		return outcome, assert.Fail(t, "Promise did not settle", "Promise is still pending after %s.", timeout)
+	}
+
+	return outcome, true
+}
+
+func AssertFulfilledWith(t TestingT, p promise.Promiser, expectedValue interface{}, timeout time.Duration) bool {
+	t.Helper()
+
+	outcome, settled := AssertSettles(t, p, timeout)
+	if !settled {
+		return false
+	}
+
+	if promise.StateFulfilled != outcome.State {
+		return assert.Fail(t, "Promise was not fulfilled", "Promise was rejected with: %v.", outcome.Reason)
+	}
+
+	return assert.Equal(t, expectedValue, outcome.Value)
+}
+
+func AssertRejectedWith(t TestingT, p promise.Promiser, expectedReason error, timeout time.Duration) bool {
+	t.Helper()
+
+	outcome, settled := assertRejected(t, p, timeout)
+	if !settled {
+		return false
+	}
+
+	return assert.Equal(t, expectedReason, outcome.Reason)
+}
+
+func AssertRejectedIs(t TestingT, p promise.Promiser, target error, timeout time.Duration) bool {
+	t.Helper()
+
+	outcome, settled := assertRejected(t, p, timeout)
+	if !settled {
+		return false
+	}
+
+	if !errors.Is(outcome.Reason, target) {
+		return assert.Fail(t, "Promise rejection reason does not match target", "Reason %q does not wrap %q.", outcome.Reason, target)
+	}
+
+	return true
+}
+
+func AssertPending(t TestingT, p promise.Promiser) bool {
+	t.Helper()
+
+	if statefulPromise, ok := p.(interface{ State() promise.State }); ok {
+		state := statefulPromise.State()
+		if promise.StatePending == state || promise.StateSettling == state {
+			return true
+		}
+
+		return assert.Fail(t, "Promise is not pending", "Promise is in state: %s.", state)
+	}
+
+	if outcome, settled := Await(p, 0); settled {
+		return assert.Fail(t, "Promise is not pending", "Promise is in state: %s.", outcome.State)
+	}
+
+	return true
+}
+
+func Eventually(t TestingT, condition func() bool, timeout time.Duration, tick time.Duration) bool {
+	t.Helper()
+
+	timeLimiter := time.After(timeout)
+
+	ticker := time.NewTicker(tick)
+	defer ticker.Stop()
+
+	for {
+		if condition() {
+			return true
+		}
+
+		select {
+		case <-timeLimiter:
+			return assert.Fail(t, "Condition never satisfied", "Condition was not satisfied within %s.", timeout)
+
+		case <-ticker.C:
+		}
+	}
+}
+
+func assertRejected(t TestingT, p promise.Promiser, timeout time.Duration) (Outcome, bool) {
+	t.Helper()
+
+	outcome, settled := AssertSettles(t, p, timeout)
+	if !settled {
+		return outcome, false
+	}
+
+	if promise.StateRejected != outcome.State {
+		return outcome, assert.Fail(t, "Promise was not rejected", "Promise was fulfilled with: %v.", outcome.Value)
+	}
+
+	return outcome, true
+}
@@ -0,0 +1,180 @@
+package promisetest
+
+import (
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/donatorsky/go-promise"
+	"github.com/jaswdr/faker"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingT struct {
+	failures []string
+}
+
+func (t *recordingT) Errorf(format string, args ...interface{}) {
+	t.failures = append(t.failures, fmt.Sprintf(format, args...))
+}
+
+func (t *recordingT) FailNow() {
+	t.failures = append(t.failures, "FailNow")
+}
+
+func (t *recordingT) Helper() {
+}
+
+func TestAwait(t *testing.T) {
+	fakerInstance := faker.New()
+
+	t.Run("Returns outcome of fulfilled Promise", func(t *testing.T) {
+		value := fakerInstance.Int()
+
+		outcome, settled := Await(promise.Resolve(value), time.Millisecond*100)
+
+		require.True(t, settled)
+		require.Equal(t, Outcome{State: promise.StateFulfilled, Value: value}, outcome)
+	})
+
+	t.Run("Returns outcome of rejected Promise", func(t *testing.T) {
+		reason := errors.New(fakerInstance.Lorem().Sentence(6))
+
+		outcome, settled := Await(promise.Reject(reason), time.Millisecond*100)
+
+		require.True(t, settled)
+		require.Equal(t, Outcome{State: promise.StateRejected, Reason: reason}, outcome)
+	})
+
+	t.Run("Times out for pending Promise", func(t *testing.T) {
+		outcome, settled := Await(promise.Pending(), time.Millisecond*10)
+
+		require.False(t, settled)
+		require.Equal(t, Outcome{State: promise.StatePending}, outcome)
+	})
+}
+
+func TestAssertFulfilledWith(t *testing.T) {
+	fakerInstance := faker.New()
+
+	t.Run("Passes for Promise fulfilled with expected value", func(t *testing.T) {
+		value := fakerInstance.Int()
+		mockT := &recordingT{}
+
+		require.True(t, AssertFulfilledWith(mockT, promise.Resolve(value), value, time.Millisecond*100))
+		require.Empty(t, mockT.failures)
+	})
+
+	for name, p := range map[string]promise.Promiser{
+		"different value": promise.Resolve(fakerInstance.Lorem().Word()),
+		"rejected":        promise.Reject(errors.New(fakerInstance.Lorem().Sentence(6))),
+		"pending":         promise.Pending(),
+	} {
+		t.Run(fmt.Sprintf("Fails for Promise: %s", name), func(t *testing.T) {
+			mockT := &recordingT{}
+
+			require.False(t, AssertFulfilledWith(mockT, p, fakerInstance.Int(), time.Millisecond*10))
+			require.Len(t, mockT.failures, 1)
+		})
+	}
+}
+
+func TestAssertRejectedWith(t *testing.T) {
+	fakerInstance := faker.New()
+
+	t.Run("Passes for Promise rejected with expected reason", func(t *testing.T) {
+		reason := errors.New(fakerInstance.Lorem().Sentence(6))
+		mockT := &recordingT{}
+
+		require.True(t, AssertRejectedWith(mockT, promise.Reject(reason), reason, time.Millisecond*100))
+		require.Empty(t, mockT.failures)
+	})
+
+	for name, p := range map[string]promise.Promiser{
+		"different reason": promise.Reject(errors.New(fakerInstance.Lorem().Sentence(6))),
+		"fulfilled":        promise.Resolve(fakerInstance.Int()),
+		"pending":          promise.Pending(),
+	} {
+		t.Run(fmt.Sprintf("Fails for Promise: %s", name), func(t *testing.T) {
+			mockT := &recordingT{}
+
+			require.False(t, AssertRejectedWith(mockT, p, errors.New("expected reason"), time.Millisecond*10))
+			require.Len(t, mockT.failures, 1)
+		})
+	}
+}
+
+func TestAssertRejectedIs(t *testing.T) {
+	fakerInstance := faker.New()
+
+	t.Run("Passes for Promise rejected with reason wrapping target", func(t *testing.T) {
+		target := errors.New(fakerInstance.Lorem().Sentence(6))
+		mockT := &recordingT{}
+
+		require.True(t, AssertRejectedIs(mockT, promise.Reject(fmt.Errorf("wrapped: %w", target)), target, time.Millisecond*100))
+		require.Empty(t, mockT.failures)
+	})
+
+	t.Run("Fails for Promise rejected with reason not wrapping target", func(t *testing.T) {
+		mockT := &recordingT{}
+
+		require.False(t, AssertRejectedIs(mockT, promise.Reject(errors.New(fakerInstance.Lorem().Sentence(6))), errors.New("target"), time.Millisecond*100))
+		require.Len(t, mockT.failures, 1)
+	})
+}
+
+func TestAssertPending(t *testing.T) {
+	fakerInstance := faker.New()
+
+	for name, p := range map[string]promise.Promiser{
+		"pending":           promise.Pending(),
+		"fake pending":      NewFakePromiser(),
+		"not stateful":      struct{ promise.Promiser }{promise.Pending()},
+		"settling":          promise.NewPromise(func(_ promise.Resolver, _ promise.Rejector) { time.Sleep(time.Millisecond * 50) }),
+		"not stateful fake": struct{ promise.Promiser }{NewFakePromiser()},
+	} {
+		t.Run(fmt.Sprintf("Passes for Promise: %s", name), func(t *testing.T) {
+			mockT := &recordingT{}
+
+			require.True(t, AssertPending(mockT, p))
+			require.Empty(t, mockT.failures)
+		})
+	}
+
+	for name, p := range map[string]promise.Promiser{
+		"fulfilled":              promise.Resolve(fakerInstance.Int()),
+		"rejected":               promise.Reject(errors.New(fakerInstance.Lorem().Sentence(6))),
+		"not stateful fulfilled": struct{ promise.Promiser }{promise.Resolve(fakerInstance.Int())},
+	} {
+		t.Run(fmt.Sprintf("Fails for Promise: %s", name), func(t *testing.T) {
+			mockT := &recordingT{}
+
+			require.False(t, AssertPending(mockT, p))
+			require.Len(t, mockT.failures, 1)
+		})
+	}
+}
+
+func TestEventually(t *testing.T) {
+	t.Run("Passes when condition becomes satisfied", func(t *testing.T) {
+		var calls int32
+
+		mockT := &recordingT{}
+
+		require.True(t, Eventually(mockT, func() bool {
+			return atomic.AddInt32(&calls, 1) >= 3
+		}, time.Millisecond*100, time.Millisecond))
+		require.Empty(t, mockT.failures)
+	})
+
+	t.Run("Fails when condition is never satisfied", func(t *testing.T) {
+		mockT := &recordingT{}
+
+		require.False(t, Eventually(mockT, func() bool {
+			return false
+		}, time.Millisecond*10, time.Millisecond))
+		require.Len(t, mockT.failures, 1)
+	})
+}
@@ -0,0 +1,173 @@
+package promisetest
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func NewCallsRegistry(expectedCalls uint) *CallsRegistry {
+	return &CallsRegistry{
+		expectedCalls: expectedCalls,
+	}
+}
+
+type CallsRegistry struct {
+	mutex sync.RWMutex
+
+	registry      []string
+	expectedCalls uint
+}
+
+func (r *CallsRegistry) Register(place string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if uint(len(r.registry)) > r.expectedCalls {
+		panic(fmt.Sprintf(
+			"trying to register an unexpected call: %s; already registered all calls: %v",
+			place,
+			r.registry,
+		))
+	}
+
+	r.registry = append(r.registry, place)
+}
+
+func (r *CallsRegistry) Summarize() string {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	return strings.Join(r.registry, "|")
+}
+
+func (r *CallsRegistry) AssertCompletedBefore(t TestingT, expectedRegistry []string, timeLimit time.Duration) {
+	t.Helper()
+
+	sort.Strings(expectedRegistry)
+
+	r.assertCallsStacksAreSameBefore(t, func() ([]string, []string) {
+		currentRegistry := r.snapshot()
+
+		sort.Strings(currentRegistry)
+
+		return expectedRegistry, currentRegistry
+	}, timeLimit)
+}
+
+func (r *CallsRegistry) AssertCompletedInOrder(t TestingT, expectedRegistry []string) {
+	t.Helper()
+
+	r.assertCallsStacksAreSame(
+		t,
+		func() ([]string, []string) { return expectedRegistry, r.snapshot() },
+	)
+}
+
+func (r *CallsRegistry) AssertCompletedInOrderBefore(t TestingT, expectedRegistry []string, timeLimit time.Duration) {
+	t.Helper()
+
+	r.assertCallsStacksAreSameBefore(
+		t,
+		func() ([]string, []string) { return expectedRegistry, r.snapshot() },
+		timeLimit,
+	)
+}
+
+func (r *CallsRegistry) AssertCompletedCallsStackIsEmpty(t TestingT) {
+	t.Helper()
+
+	require.Empty(t, r.snapshot())
+	r.AssertCurrentCallsStackIsEmpty(t)
+}
+
+func (r *CallsRegistry) AssertCurrentCallsStackIs(t TestingT, expectedRegistry []string) {
+	t.Helper()
+
+	if nil == expectedRegistry {
+		require.Empty(t, r.snapshot())
+
+		return
+	}
+
+	currentRegistry := r.snapshot()
+
+	sort.Strings(currentRegistry)
+	sort.Strings(expectedRegistry)
+
+	require.Equal(t, expectedRegistry, currentRegistry)
+}
+
+func (r *CallsRegistry) AssertCurrentCallsStackInOrderIs(t TestingT, expectedRegistry []string) {
+	t.Helper()
+
+	require.Equal(t, expectedRegistry, r.snapshot())
+}
+
+func (r *CallsRegistry) AssertCurrentCallsStackIsEmpty(t TestingT) {
+	t.Helper()
+
+	r.AssertCurrentCallsStackIs(t, nil)
+}
+
+func (r *CallsRegistry) AssertThereAreNCallsLeft(t TestingT, numberOfCallsLeft uint) {
+	t.Helper()
+
+	numberOfCurrentCalls := uint(len(r.snapshot()))
+
+	require.LessOrEqual(t, numberOfCurrentCalls, r.expectedCalls)
+	require.Equal(t, numberOfCallsLeft, r.expectedCalls-numberOfCurrentCalls)
+}
+
+func (r *CallsRegistry) snapshot() []string {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	currentRegistry := make([]string, len(r.registry))
+	copy(currentRegistry, r.registry)
+
+	return currentRegistry
+}
+
+func (r *CallsRegistry) assertCallsStacksAreSame(t TestingT, h func() ([]string, []string)) {
+	expectedRegistry, currentRegistry := h()
+
+	require.Equal(t, expectedRegistry, currentRegistry)
+}
+
+func (r *CallsRegistry) assertCallsStacksAreSameBefore(t TestingT, h func() ([]string, []string), timeLimit time.Duration) {
+	timeLimiter := time.After(timeLimit)
+
+	for {
+		expectedRegistry, currentRegistry := h()
+
+		select {
+		case <-timeLimiter:
+			require.FailNowf(
+				t,
+				"Calls registry assertion timeout",
+				"There are still %d expected call(s) left. Calls registered (%d): %v.",
+				r.expectedCalls-uint(len(currentRegistry)),
+				len(currentRegistry),
+				currentRegistry,
+			)
+			return
+
+		default:
+			if 0 == r.expectedCalls {
+				time.Sleep(timeLimit)
+			}
+
+			if uint(len(currentRegistry)) < r.expectedCalls {
+				continue
+			}
+
+			require.Equal(t, expectedRegistry, currentRegistry)
+			return
+		}
+	}
+}
@@ -0,0 +1,214 @@
+package promisetest
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/donatorsky/go-promise"
+)
+
+type Registration struct {
+	Method     string
+	Target     interface{}
+	Handler    interface{}
+	OnRejected promise.RecoverHandler
+	Derived    *FakePromiser
+}
+
+func NewFakePromiser() *FakePromiser {
+	return &FakePromiser{
+		state: promise.StatePending,
+	}
+}
+
+type FakePromiser struct {
+	mutex sync.Mutex
+	state promise.State
+
+	registrations []Registration
+
+	value interface{}
+	err   error
+}
+
+func (f *FakePromiser) Then(handler promise.FulfillHandler) promise.Promiser {
+	return f.register(Registration{Method: "Then", Handler: handler})
+}
+
+func (f *FakePromiser) ThenCatch(onFulfilled promise.FulfillHandler, onRejected promise.RecoverHandler) promise.Promiser {
+	return f.register(Registration{Method: "ThenCatch", Handler: onFulfilled, OnRejected: onRejected})
+}
+
+func (f *FakePromiser) Catch(handler promise.RejectHandler) promise.Promiser {
+	return f.register(Registration{Method: "Catch", Handler: handler})
+}
+
+func (f *FakePromiser) CatchIs(target error, handler promise.RejectHandler) promise.Promiser {
+	return f.register(Registration{Method: "CatchIs", Target: target, Handler: handler})
+}
+
+func (f *FakePromiser) CatchAs(target interface{}, handler promise.RejectHandler) promise.Promiser {
+	return f.register(Registration{Method: "CatchAs", Target: target, Handler: handler})
+}
+
+func (f *FakePromiser) CatchAsync(handler promise.AsyncRejectHandler) promise.Promiser {
+	return f.register(Registration{Method: "CatchAsync", Handler: handler})
+}
+
+func (f *FakePromiser) Finally(handler promise.FinallyHandler) promise.Promiser {
+	return f.register(Registration{Method: "Finally", Handler: handler})
+}
+
+func (f *FakePromiser) FinallyAsync(handler promise.AsyncFinallyHandler) promise.Promiser {
+	return f.register(Registration{Method: "FinallyAsync", Handler: handler})
+}
+
+func (f *FakePromiser) Tap(handler promise.TapHandler) promise.Promiser {
+	return f.register(Registration{Method: "Tap", Handler: handler})
+}
+
+func (f *FakePromiser) TapError(handler promise.TapErrorHandler) promise.Promiser {
+	return f.register(Registration{Method: "TapError", Handler: handler})
+}
+
+func (f *FakePromiser) Resolve(value interface{}) error {
+	f.mutex.Lock()
+
+	if promise.StatePending != f.state {
+		f.mutex.Unlock()
+
+		return promise.ErrResolveNotPendingPromise
+	}
+
+	f.state = promise.StateFulfilled
+	f.value = value
+
+	registrations := f.copyRegistrations()
+
+	f.mutex.Unlock()
+
+	for _, registration := range registrations {
+		f.invoke(registration)
+	}
+
+	return nil
+}
+
+func (f *FakePromiser) Reject(reason error) error {
+	f.mutex.Lock()
+
+	if promise.StatePending != f.state {
+		f.mutex.Unlock()
+
+		return promise.ErrRejectNotPendingPromise
+	}
+
+	f.state = promise.StateRejected
+	f.err = reason
+
+	registrations := f.copyRegistrations()
+
+	f.mutex.Unlock()
+
+	for _, registration := range registrations {
+		f.invoke(registration)
+	}
+
+	return nil
+}
+
+func (f *FakePromiser) State() promise.State {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	return f.state
+}
+
+func (f *FakePromiser) Registrations() []Registration {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	return f.copyRegistrations()
+}
+
+func (f *FakePromiser) register(registration Registration) promise.Promiser {
+	registration.Derived = NewFakePromiser()
+
+	f.mutex.Lock()
+
+	f.registrations = append(f.registrations, registration)
+	isSettled := promise.StatePending != f.state
+
+	f.mutex.Unlock()
+
+	if isSettled {
+		f.invoke(registration)
+	}
+
+	return registration.Derived
+}
+
+func (f *FakePromiser) copyRegistrations() []Registration {
+	registrations := make([]Registration, len(f.registrations))
+	copy(registrations, f.registrations)
+
+	return registrations
+}
+
+func (f *FakePromiser) invoke(registration Registration) {
+	f.mutex.Lock()
+	state, value, reason := f.state, f.value, f.err
+	f.mutex.Unlock()
+
+	isFulfilled := promise.StateFulfilled == state
+
+	switch handler := registration.Handler.(type) {
+	case promise.FulfillHandler:
+		if isFulfilled && nil != handler {
+			_, _ = handler(value)
+		} else if !isFulfilled && nil != registration.OnRejected {
+			_, _ = registration.OnRejected(reason)
+		}
+
+	case promise.RejectHandler:
+		if !isFulfilled && f.matches(registration, reason) {
+			handler(reason)
+		}
+
+	case promise.AsyncRejectHandler:
+		if !isFulfilled {
+			handler(reason)
+		}
+
+	case promise.FinallyHandler:
+		handler()
+
+	case promise.AsyncFinallyHandler:
+		handler()
+
+	case promise.TapHandler:
+		if isFulfilled {
+			handler(value)
+		}
+
+	case promise.TapErrorHandler:
+		if !isFulfilled {
+			handler(reason)
+		}
+	}
+}
+
+func (f *FakePromiser) matches(registration Registration, reason error) bool {
+	switch registration.Method {
+	case "CatchIs":
+		target, _ := registration.Target.(error)
+
+		return errors.Is(reason, target)
+
+	case "CatchAs":
+		return errors.As(reason, registration.Target)
+
+	default:
+		return true
+	}
+}
@@ -0,0 +1,147 @@
+package promisetest
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/donatorsky/go-promise"
+	"github.com/jaswdr/faker"
+	"github.com/stretchr/testify/require"
+)
+
+type fakePromiserTestError struct {
+	message string
+}
+
+func (e *fakePromiserTestError) Error() string {
+	return e.message
+}
+
+func TestFakePromiser(t *testing.T) {
+	fakerInstance := faker.New()
+
+	t.Run("Fake Promiser can be created", func(t *testing.T) {
+		fake := NewFakePromiser()
+
+		require.Implements(t, (*promise.Promiser)(nil), fake)
+		require.Equal(t, promise.StatePending, fake.State())
+		require.Empty(t, fake.Registrations())
+	})
+
+	t.Run("Records registered handlers and returns derived fakes", func(t *testing.T) {
+		fake := NewFakePromiser()
+		target := errors.New(fakerInstance.Lorem().Sentence(6))
+
+		derived := fake.Then(func(_ interface{}) (interface{}, error) { return nil, nil })
+		fake.CatchIs(target, func(_ error) {})
+		fake.Finally(func() {})
+
+		registrations := fake.Registrations()
+
+		require.Len(t, registrations, 3)
+		require.Equal(t, "Then", registrations[0].Method)
+		require.Same(t, derived, registrations[0].Derived)
+		require.Equal(t, "CatchIs", registrations[1].Method)
+		require.Same(t, target, registrations[1].Target)
+		require.Equal(t, "Finally", registrations[2].Method)
+		require.Equal(t, promise.StatePending, registrations[0].Derived.State())
+	})
+
+	t.Run("Resolve invokes fulfilment handlers", func(t *testing.T) {
+		callsStack := NewCallsRegistry(5)
+		fake := NewFakePromiser()
+		value := fakerInstance.Int()
+
+		fake.Then(func(v interface{}) (interface{}, error) {
+			require.Equal(t, value, v)
+
+			callsStack.Register("Then")
+
+			return nil, nil
+		})
+		fake.ThenCatch(
+			func(v interface{}) (interface{}, error) {
+				callsStack.Register("ThenCatch.onFulfilled")
+
+				return nil, nil
+			},
+			func(_ error) (interface{}, error) {
+				callsStack.Register("ThenCatch.onRejected")
+
+				return nil, nil
+			},
+		)
+		fake.Catch(func(_ error) {
+			callsStack.Register("Catch")
+		})
+		fake.Tap(func(_ interface{}) {
+			callsStack.Register("Tap")
+		})
+		fake.TapError(func(_ error) {
+			callsStack.Register("TapError")
+		})
+		fake.Finally(func() {
+			callsStack.Register("Finally")
+		})
+
+		require.NoError(t, fake.Resolve(value))
+		require.ErrorIs(t, fake.Resolve(value), promise.ErrResolveNotPendingPromise)
+		require.Equal(t, promise.StateFulfilled, fake.State())
+
+		fake.FinallyAsync(func() promise.Promiser {
+			callsStack.Register("FinallyAsync")
+
+			return nil
+		})
+
+		callsStack.AssertCompletedInOrder(t, []string{"Then", "ThenCatch.onFulfilled", "Tap", "Finally", "FinallyAsync"})
+	})
+
+	t.Run("Reject invokes rejection handlers", func(t *testing.T) {
+		callsStack := NewCallsRegistry(5)
+		fake := NewFakePromiser()
+		reason := &fakePromiserTestError{message: fakerInstance.Lorem().Sentence(6)}
+
+		var target *fakePromiserTestError
+
+		fake.Then(func(_ interface{}) (interface{}, error) {
+			callsStack.Register("Then")
+
+			return nil, nil
+		})
+		fake.ThenCatch(nil, func(r error) (interface{}, error) {
+			require.ErrorIs(t, r, reason)
+
+			callsStack.Register("ThenCatch.onRejected")
+
+			return nil, nil
+		})
+		fake.CatchIs(errors.New(fakerInstance.Lorem().Sentence(6)), func(_ error) {
+			callsStack.Register("CatchIs")
+		})
+		fake.CatchAs(&target, func(_ error) {
+			require.Same(t, reason, target)
+
+			callsStack.Register("CatchAs")
+		})
+		fake.CatchAsync(func(_ error) promise.Promiser {
+			callsStack.Register("CatchAsync")
+
+			return nil
+		})
+		fake.TapError(func(_ error) {
+			callsStack.Register("TapError")
+		})
+
+		require.NoError(t, fake.Reject(fmt.Errorf("wrapped: %w", reason)))
+		require.ErrorIs(t, fake.Reject(reason), promise.ErrRejectNotPendingPromise)
+		require.Equal(t, promise.StateRejected, fake.State())
+
+		fake.Catch(func(_ error) {
+			callsStack.Register("Catch")
+		})
+
+		callsStack.AssertCompletedInOrder(t, []string{"ThenCatch.onRejected", "CatchAs", "CatchAsync", "TapError", "Catch"})
+	})
+}
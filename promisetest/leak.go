@@ -0,0 +1,106 @@
+package promisetest
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/donatorsky/go-promise"
+	"github.com/stretchr/testify/assert"
+)
+
+var leakRetryTimeout = time.Second
+
+var (
+	verificationsMutex sync.Mutex
+	verifications      = make(map[*verification]struct{})
+)
+
+type cleaner interface {
+	Cleanup(func())
+}
+
+type verification struct {
+	isOverlapping bool
+}
+
+func startVerification() *verification {
+	started := &verification{}
+
+	verificationsMutex.Lock()
+	for other := range verifications {
+		other.isOverlapping = true
+		started.isOverlapping = true
+	}
+	verifications[started] = struct{}{}
+	verificationsMutex.Unlock()
+
+	return started
+}
+
+func (v *verification) stop() bool {
+	verificationsMutex.Lock()
+	delete(verifications, v)
+	isOverlapping := v.isOverlapping
+	verificationsMutex.Unlock()
+
+	return isOverlapping
+}
+
+func VerifyNoPendingPromises(t TestingT) {
+	t.Helper()
+
+	testCleaner, ok := t.(cleaner)
+	if !ok {
+		assert.Fail(t, "Cannot verify pending promises", "%T does not support registering cleanup functions.", t)
+
+		return
+	}
+
+	tracker := promise.StartTracking()
+	started := startVerification()
+
+	testCleaner.Cleanup(func() {
+		defer tracker.Stop()
+
+		t.Helper()
+
+		if started.stop() {
+			assert.Fail(t, "Cannot verify pending promises", "VerifyNoPendingPromises was used by tests running in parallel. Promise tracking is process-wide, so promises of one test would be reported as leaked by another. Do not call t.Parallel() in tests verifying pending promises.")
+
+			return
+		}
+
+		deadline := time.Now().Add(leakRetryTimeout)
+
+		for {
+			promises, executors := tracker.UnsettledPromises(), tracker.RunningExecutors()
+			if 0 == len(promises) && 0 == len(executors) {
+				return
+			}
+
+			if time.Now().After(deadline) {
+				assert.Fail(t, "Found leaked promises", describeLeaks(promises, executors))
+
+				return
+			}
+
+			time.Sleep(time.Millisecond * 10)
+		}
+	})
+}
+
+func describeLeaks(promises, executors []*promise.Promise) string {
+	var description strings.Builder
+
+	for _, p := range promises {
+		_, _ = fmt.Fprintf(&description, "Promise #%d is still %s, created at:\n%s\n", p.ID(), p.State(), p.CreationStack())
+	}
+
+	for _, p := range executors {
+		_, _ = fmt.Fprintf(&description, "Executor of promise #%d is still running, created at:\n%s\n", p.ID(), p.CreationStack())
+	}
+
+	return description.String()
+}
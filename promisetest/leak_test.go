@@ -0,0 +1,142 @@
+package promisetest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/donatorsky/go-promise"
+	"github.com/jaswdr/faker"
+	"github.com/stretchr/testify/require"
+)
+
+type cleanupRecordingT struct {
+	recordingT
+
+	cleanups []func()
+}
+
+func (t *cleanupRecordingT) Cleanup(cleanup func()) {
+	t.cleanups = append(t.cleanups, cleanup)
+}
+
+func (t *cleanupRecordingT) runCleanups() {
+	for _, cleanup := range t.cleanups {
+		cleanup()
+	}
+}
+
+func TestVerifyNoPendingPromises(t *testing.T) {
+	fakerInstance := faker.New()
+
+	defaultLeakRetryTimeout := leakRetryTimeout
+	leakRetryTimeout = time.Millisecond * 50
+
+	defer func() {
+		leakRetryTimeout = defaultLeakRetryTimeout
+	}()
+
+	t.Run("Passes when all promises are settled", func(t *testing.T) {
+		mockT := &cleanupRecordingT{}
+
+		VerifyNoPendingPromises(mockT)
+
+		p := promise.Pending()
+		p.Then(func(value interface{}) (interface{}, error) {
+			return value, nil
+		})
+
+		require.NoError(t, p.Resolve(fakerInstance.Int()))
+
+		mockT.runCleanups()
+
+		require.Empty(t, mockT.failures)
+	})
+
+	t.Run("Passes when promises settle shortly after test end", func(t *testing.T) {
+		mockT := &cleanupRecordingT{}
+
+		VerifyNoPendingPromises(mockT)
+
+		promise.NewPromise(func(resolve promise.Resolver, _ promise.Rejector) {
+			time.Sleep(time.Millisecond * 10)
+
+			resolve(fakerInstance.Int())
+		})
+
+		mockT.runCleanups()
+
+		require.Empty(t, mockT.failures)
+	})
+
+	t.Run("Fails and reports creation stack of pending promise", func(t *testing.T) {
+		mockT := &cleanupRecordingT{}
+
+		VerifyNoPendingPromises(mockT)
+
+		p := promise.Pending()
+
+		mockT.runCleanups()
+
+		require.Len(t, mockT.failures, 1)
+		require.Contains(t, mockT.failures[0], "is still pending")
+		require.Contains(t, mockT.failures[0], "promisetest.TestVerifyNoPendingPromises")
+
+		require.NoError(t, p.Resolve(nil))
+	})
+
+	t.Run("Fails and reports running executor", func(t *testing.T) {
+		waitGroup := NewWaitGroup()
+		mockT := &cleanupRecordingT{}
+
+		waitGroup.Initialize("root", 1)
+
+		VerifyNoPendingPromises(mockT)
+
+		promise.NewPromise(func(resolve promise.Resolver, _ promise.Rejector) {
+			waitGroup.Wait("root")
+
+			resolve(nil)
+		})
+
+		mockT.runCleanups()
+
+		require.Len(t, mockT.failures, 1)
+		require.Contains(t, mockT.failures[0], "is still settling")
+		require.Contains(t, mockT.failures[0], "is still running")
+
+		waitGroup.Done("root")
+	})
+
+	t.Run("Fails loudly when tests verify pending promises in parallel", func(t *testing.T) {
+		firstT, secondT := &cleanupRecordingT{}, &cleanupRecordingT{}
+
+		VerifyNoPendingPromises(firstT)
+		VerifyNoPendingPromises(secondT)
+
+		promise.Pending()
+
+		firstT.runCleanups()
+		secondT.runCleanups()
+
+		for _, mockT := range []*cleanupRecordingT{firstT, secondT} {
+			require.Len(t, mockT.failures, 1)
+			require.Contains(t, mockT.failures[0], "used by tests running in parallel")
+			require.NotContains(t, mockT.failures[0], "is still pending")
+		}
+
+		afterT := &cleanupRecordingT{}
+
+		VerifyNoPendingPromises(afterT)
+		afterT.runCleanups()
+
+		require.Empty(t, afterT.failures)
+	})
+
+	t.Run("Fails when test does not support cleanup functions", func(t *testing.T) {
+		mockT := &recordingT{}
+
+		VerifyNoPendingPromises(mockT)
+
+		require.Len(t, mockT.failures, 1)
+	})
+}
@@ -0,0 +1,7 @@
+package promisetest
+
+type TestingT interface {
+	Errorf(format string, args ...interface{})
+	FailNow()
+	Helper()
+}
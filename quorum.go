@@ -0,0 +1,231 @@
+package promise
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+var (
+	ErrNotEnoughPromises = errors.New("not enough promises to satisfy the requirement")
+	ErrQuorumNotReached  = errors.New("quorum not reached")
+)
+
+type QuorumError struct {
+	Required int
+	Agreeing int
+	Errors   []error
+}
+
+func (e *QuorumError) Error() string {
+	return fmt.Sprintf("%s: required %d agreeing values, got at most %d, %d rejected", ErrQuorumNotReached, e.Required, e.Agreeing, len(e.Errors))
+}
+
+func (e *QuorumError) Is(target error) bool {
+	if ErrQuorumNotReached == target {
+		return true
+	}
+
+	for _, err := range e.Errors {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func Some(n int, promises ...Promiser) *Promise {
+	return defaultRuntime.Some(n, promises...)
+}
+
+func Quorum(k int, promises ...Promiser) *Promise {
+	return defaultRuntime.Quorum(k, promises...)
+}
+
+func (rt *Runtime) Some(n int, promises ...Promiser) *Promise {
+	if 0 >= n {
+		return rt.Resolve([]interface{}{})
+	}
+
+	if n > len(promises) {
+		return rt.Reject(ErrNotEnoughPromises)
+	}
+
+	result := rt.Pending()
+
+	var mutex sync.Mutex
+
+	values := make([]interface{}, 0, n)
+	reasons := make([]error, 0, len(promises))
+
+	for _, p := range promises {
+		p.ThenCatch(
+			func(value interface{}) (interface{}, error) {
+				mutex.Lock()
+				if len(values) == n {
+					mutex.Unlock()
+
+					return nil, nil
+				}
+
+				values = append(values, value)
+				isSatisfied := len(values) == n
+				mutex.Unlock()
+
+				if isSatisfied {
+					_ = result.Resolve(values)
+				}
+
+				return nil, nil
+			},
+			func(reason error) (interface{}, error) {
+				mutex.Lock()
+				reasons = append(reasons, reason)
+				isImpossible := len(promises)-len(reasons) < n
+				var err error
+				if isImpossible {
+					err = &AggregateError{Errors: append([]error(nil), reasons...)}
+				}
+				mutex.Unlock()
+
+				if isImpossible {
+					_ = result.Reject(err)
+				}
+
+				return nil, nil
+			},
+		)
+	}
+
+	return result
+}
+
+func (rt *Runtime) Quorum(k int, promises ...Promiser) *Promise {
+	if 0 >= k {
+		return rt.Resolve(nil)
+	}
+
+	if k > len(promises) {
+		return rt.Reject(ErrNotEnoughPromises)
+	}
+
+	result := rt.Pending()
+	votes := &quorumVotes{required: k, pending: len(promises)}
+
+	for _, p := range promises {
+		p.ThenCatch(
+			func(value interface{}) (interface{}, error) {
+				votes.settle(result, votes.vote(value))
+
+				return nil, nil
+			},
+			func(reason error) (interface{}, error) {
+				votes.settle(result, votes.reject(reason))
+
+				return nil, nil
+			},
+		)
+	}
+
+	return result
+}
+
+type quorumCandidate struct {
+	value interface{}
+	votes int
+}
+
+type quorumVotes struct {
+	mutex sync.Mutex
+
+	required   int
+	pending    int
+	candidates []*quorumCandidate
+	reasons    []error
+	isDecided  bool
+}
+
+type quorumDecision struct {
+	isDecided bool
+	value     interface{}
+	err       error
+}
+
+func (v *quorumVotes) vote(value interface{}) quorumDecision {
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+
+	v.pending--
+
+	var voted *quorumCandidate
+	for _, candidate := range v.candidates {
+		if reflect.DeepEqual(candidate.value, value) {
+			voted = candidate
+
+			break
+		}
+	}
+
+	if nil == voted {
+		voted = &quorumCandidate{value: value}
+		v.candidates = append(v.candidates, voted)
+	}
+
+	voted.votes++
+
+	if !v.isDecided && voted.votes >= v.required {
+		v.isDecided = true
+
+		return quorumDecision{isDecided: true, value: value}
+	}
+
+	return v.checkImpossible()
+}
+
+func (v *quorumVotes) reject(reason error) quorumDecision {
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+
+	v.pending--
+	v.reasons = append(v.reasons, reason)
+
+	return v.checkImpossible()
+}
+
+func (v *quorumVotes) checkImpossible() quorumDecision {
+	mostVotes := 0
+	for _, candidate := range v.candidates {
+		if candidate.votes > mostVotes {
+			mostVotes = candidate.votes
+		}
+	}
+
+	if v.isDecided || mostVotes+v.pending >= v.required {
+		return quorumDecision{}
+	}
+
+	v.isDecided = true
+
+	return quorumDecision{
+		isDecided: true,
+		err: &QuorumError{
+			Required: v.required,
+			Agreeing: mostVotes,
+			Errors:   append([]error(nil), v.reasons...),
+		},
+	}
+}
+
+func (v *quorumVotes) settle(result *Promise, decision quorumDecision) {
+	if !decision.isDecided {
+		return
+	}
+
+	if nil != decision.err {
+		_ = result.Reject(decision.err)
+	} else {
+		_ = result.Resolve(decision.value)
+	}
+}
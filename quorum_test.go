@@ -0,0 +1,111 @@
+package promise
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jaswdr/faker"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSome(t *testing.T) {
+	fakerInstance := faker.New()
+
+	t.Run("Fulfills with the first n values", func(t *testing.T) {
+		first, second, third := Pending(), Pending(), Pending()
+
+		promise := Some(2, first, second, third)
+
+		require.NoError(t, third.Resolve(3))
+		require.NoError(t, first.Reject(errors.New(fakerInstance.Lorem().Sentence(6))))
+		require.Equal(t, StatePending, promise.State())
+
+		require.NoError(t, second.Resolve(2))
+		require.Equal(t, StateFulfilled, promise.State())
+		require.Equal(t, []interface{}{3, 2}, promise.value)
+	})
+
+	t.Run("Rejects once success is impossible", func(t *testing.T) {
+		firstReason := errors.New(fakerInstance.Lorem().Sentence(6))
+		secondReason := errors.New(fakerInstance.Lorem().Sentence(6))
+		first, second, third := Pending(), Pending(), Pending()
+
+		promise := Some(2, first, second, third)
+
+		require.NoError(t, first.Reject(firstReason))
+		require.Equal(t, StatePending, promise.State())
+
+		require.NoError(t, second.Reject(secondReason))
+		require.Equal(t, StateRejected, promise.State())
+
+		var aggregateErr *AggregateError
+
+		require.True(t, errors.As(promise.err, &aggregateErr))
+		require.Equal(t, []error{firstReason, secondReason}, aggregateErr.Errors)
+	})
+
+	t.Run("Handles edge cases", func(t *testing.T) {
+		fulfilled := Some(0, Pending())
+
+		require.Equal(t, StateFulfilled, fulfilled.State())
+		require.Equal(t, []interface{}{}, fulfilled.value)
+
+		rejected := Some(2, Resolve(fakerInstance.Int()))
+
+		require.Equal(t, StateRejected, rejected.State())
+		require.Same(t, ErrNotEnoughPromises, rejected.err)
+	})
+}
+
+func TestQuorum(t *testing.T) {
+	fakerInstance := faker.New()
+
+	t.Run("Fulfills once k promises agree", func(t *testing.T) {
+		first, second, third := Pending(), Pending(), Pending()
+
+		promise := Quorum(2, first, second, third)
+
+		require.NoError(t, first.Resolve([]string{"a", "b"}))
+		require.NoError(t, second.Resolve([]string{"c"}))
+		require.Equal(t, StatePending, promise.State())
+
+		require.NoError(t, third.Resolve([]string{"a", "b"}))
+		require.Equal(t, StateFulfilled, promise.State())
+		require.Equal(t, []string{"a", "b"}, promise.value)
+	})
+
+	t.Run("Rejects once agreement is impossible", func(t *testing.T) {
+		reason := errors.New(fakerInstance.Lorem().Sentence(6))
+		first, second, third := Pending(), Pending(), Pending()
+
+		promise := Quorum(2, first, second, third)
+
+		require.NoError(t, first.Resolve(1))
+		require.Equal(t, StatePending, promise.State())
+
+		require.NoError(t, second.Reject(reason))
+		require.Equal(t, StatePending, promise.State())
+
+		require.NoError(t, third.Resolve(3))
+		require.Equal(t, StateRejected, promise.State())
+
+		var quorumErr *QuorumError
+
+		require.True(t, errors.As(promise.err, &quorumErr))
+		require.Equal(t, &QuorumError{Required: 2, Agreeing: 1, Errors: []error{reason}}, quorumErr)
+		require.ErrorIs(t, promise.err, ErrQuorumNotReached)
+		require.ErrorIs(t, promise.err, reason)
+		require.EqualError(t, promise.err, "quorum not reached: required 2 agreeing values, got at most 1, 1 rejected")
+	})
+
+	t.Run("Handles edge cases", func(t *testing.T) {
+		fulfilled := Quorum(0)
+
+		require.Equal(t, StateFulfilled, fulfilled.State())
+
+		rejected := Quorum(3, Resolve(1), Resolve(1))
+
+		require.Equal(t, StateRejected, rejected.State())
+		require.Same(t, ErrNotEnoughPromises, rejected.err)
+	})
+}
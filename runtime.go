@@ -0,0 +1,180 @@
+package promise
+
+import (
+	"runtime"
+	"sync"
+	"time"
+)
+
+var defaultRuntime = NewRuntime()
+
+type Clock interface {
+	Now() time.Time
+}
+
+type ClockFunc func() time.Time
+
+func (f ClockFunc) Now() time.Time {
+	return f()
+}
+
+var SystemClock Clock = ClockFunc(time.Now)
+
+type UnhandledRejectionHandler func(promise *Promise, reason error)
+
+type Runtime struct {
+	defaults options
+}
+
+func NewRuntime(opts ...Option) *Runtime {
+	rt := &Runtime{
+		defaults: options{
+			executor:    GoroutineExecutor,
+			panicPolicy: PanicPropagate,
+			clock:       SystemClock,
+		},
+	}
+
+	for _, opt := range opts {
+		opt(&rt.defaults)
+	}
+
+	return rt
+}
+
+func DefaultRuntime() *Runtime {
+	return defaultRuntime
+}
+
+func (rt *Runtime) NewPromise(callback func(resolve Resolver, reject Rejector), opts ...Option) *Promise {
+	return newPromise(callback, rt.makeOptions(opts))
+}
+
+func (rt *Runtime) Pending(opts ...Option) *Promise {
+	return makePromise(StatePending, nil, nil, rt.makeOptions(opts))
+}
+
+func (rt *Runtime) Resolve(value interface{}, opts ...Option) *Promise {
+	return newResolved(value, rt.makeOptions(opts))
+}
+
+func (rt *Runtime) Reject(reason error, opts ...Option) *Promise {
+	return makePromise(StateRejected, nil, reason, rt.makeOptions(opts))
+}
+
+func (rt *Runtime) All(promises ...Promiser) *Promise {
+	result := rt.Pending()
+
+	if 0 == len(promises) {
+		_ = result.Resolve([]interface{}{})
+
+		return result
+	}
+
+	var mutex sync.Mutex
+
+	values := make([]interface{}, len(promises))
+	remaining := len(promises)
+
+	for i, p := range promises {
+		i := i
+
+		p.ThenCatch(
+			func(value interface{}) (interface{}, error) {
+				mutex.Lock()
+				values[i] = value
+				remaining--
+				isLast := 0 == remaining
+				mutex.Unlock()
+
+				if isLast {
+					_ = result.Resolve(values)
+				}
+
+				return nil, nil
+			},
+			func(reason error) (interface{}, error) {
+				_ = result.Reject(reason)
+
+				return nil, nil
+			},
+		)
+	}
+
+	return result
+}
+
+func (rt *Runtime) Race(promises ...Promiser) *Promise {
+	result := rt.Pending()
+
+	for _, p := range promises {
+		p.ThenCatch(
+			func(value interface{}) (interface{}, error) {
+				_ = result.Resolve(value)
+
+				return nil, nil
+			},
+			func(reason error) (interface{}, error) {
+				_ = result.Reject(reason)
+
+				return nil, nil
+			},
+		)
+	}
+
+	return result
+}
+
+func (rt *Runtime) makeOptions(opts []Option) options {
+	o := rt.defaults
+
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	if nil == o.executor {
+		o.executor = GoroutineExecutor
+	}
+
+	if nil == o.clock {
+		o.clock = SystemClock
+	}
+
+	if defaultRuntime != rt {
+		return o
+	}
+
+	if !o.tracerIsSet {
+		o.tracer = currentTracer()
+	}
+
+	if !o.metricsIsSet {
+		o.metrics = currentMetrics()
+	}
+
+	return o
+}
+
+func (p *Promise) now() time.Time {
+	if nil == p.clock {
+		return time.Now()
+	}
+
+	return p.clock.Now()
+}
+
+func (p *Promise) watchUnhandledRejection() {
+	p.mutex.RLock()
+	isUnhandled := StateRejected == p.state && !p.handled && nil != p.onUnhandledRejection
+	p.mutex.RUnlock()
+
+	if !isUnhandled {
+		return
+	}
+
+	runtime.SetFinalizer(p, func(p *Promise) {
+		if !p.handled {
+			p.onUnhandledRejection(p, p.err)
+		}
+	})
+}
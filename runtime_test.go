@@ -0,0 +1,278 @@
+package promise
+
+import (
+	"errors"
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jaswdr/faker"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeClock struct {
+	mutex sync.Mutex
+	now   time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.now
+}
+
+func (c *fakeClock) Advance(duration time.Duration) {
+	c.mutex.Lock()
+	c.now = c.now.Add(duration)
+	c.mutex.Unlock()
+}
+
+var synchronousExecutor = ExecutorFunc(func(task func()) {
+	task()
+})
+
+func TestNewRuntime(t *testing.T) {
+	fakerInstance := faker.New()
+
+	t.Run("Applies its defaults to created promises", func(t *testing.T) {
+		clock := &fakeClock{now: time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)}
+		recorder := NewTraceRecorder()
+		collector := NewMetricsCollector()
+
+		rt := NewRuntime(
+			WithExecutor(synchronousExecutor),
+			WithClock(clock),
+			WithTracer(recorder),
+			WithMetrics(collector),
+		)
+
+		value := fakerInstance.Int()
+
+		promise := rt.NewPromise(func(resolve Resolver, _ Rejector) {
+			clock.Advance(time.Second)
+
+			resolve(value)
+		})
+
+		require.Equal(t, StateFulfilled, promise.State())
+		require.Equal(t, value, promise.value)
+		require.Equal(t, time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC), promise.createdAt)
+		require.Equal(t, time.Second, collector.Snapshot().SettleLatency[unnamedMetricsKey].Sum)
+		require.Len(t, recorder.Events(), 2)
+
+		Pending()
+
+		require.Len(t, recorder.Events(), 2)
+		require.Equal(t, int64(1), collector.Snapshot().Created)
+	})
+
+	t.Run("Lets options override its defaults", func(t *testing.T) {
+		rt := NewRuntime(WithExecutor(synchronousExecutor), WithName("default"))
+
+		require.Equal(t, "default", rt.Pending().Name())
+		require.Equal(t, "custom", rt.Pending(WithName("custom")).Name())
+
+		promise := rt.NewPromise(func(resolve Resolver, _ Rejector) {
+			time.Sleep(10 * time.Millisecond)
+
+			resolve(nil)
+		}, WithExecutor(GoroutineExecutor))
+
+		require.Equal(t, StateSettling, promise.State())
+	})
+
+	t.Run("Applies its panic policy", func(t *testing.T) {
+		rt := NewRuntime(WithExecutor(synchronousExecutor), WithPanicPolicy(PanicReject))
+
+		promise := rt.NewPromise(func(_ Resolver, _ Rejector) {
+			panic("boom")
+		})
+
+		var panicErr *PanicError
+
+		require.Equal(t, StateRejected, promise.State())
+		require.True(t, errors.As(promise.err, &panicErr))
+	})
+
+	t.Run("Creates settled promises", func(t *testing.T) {
+		rt := NewRuntime()
+
+		value := fakerInstance.Int()
+		reason := errors.New(fakerInstance.Lorem().Sentence(6))
+
+		require.Equal(t, StateFulfilled, rt.Resolve(value).State())
+		require.Equal(t, StateRejected, rt.Reject(reason).State())
+		require.Same(t, reason, rt.Reject(reason).err)
+	})
+
+	t.Run("Does not fall back to the global tracer and metrics", func(t *testing.T) {
+		recorder := NewTraceRecorder()
+		collector := NewMetricsCollector()
+
+		SetTracer(recorder)
+		defer SetTracer(nil)
+
+		SetMetrics(collector)
+		defer SetMetrics(nil)
+
+		promise := NewRuntime().Pending()
+
+		require.Nil(t, promise.tracer)
+		require.Nil(t, promise.metrics)
+		require.Empty(t, recorder.Events())
+		require.Equal(t, int64(0), collector.Snapshot().Created)
+
+		promise = Pending()
+
+		require.Same(t, recorder, promise.tracer)
+		require.Same(t, collector, promise.metrics)
+	})
+
+	t.Run("Package functions use the default runtime", func(t *testing.T) {
+		require.NotNil(t, DefaultRuntime())
+		require.Same(t, defaultRuntime, DefaultRuntime())
+	})
+}
+
+func TestWithUnhandledRejectionHandler(t *testing.T) {
+	fakerInstance := faker.New()
+
+	awaitReport := func(reports chan error, timeout time.Duration) (error, bool) {
+		deadline := time.After(timeout)
+
+		for {
+			runtime.GC()
+
+			select {
+			case reason := <-reports:
+				return reason, true
+
+			case <-deadline:
+				return nil, false
+
+			case <-time.After(10 * time.Millisecond):
+			}
+		}
+	}
+
+	t.Run("Reports rejections without handlers", func(t *testing.T) {
+		reports := make(chan error, 10)
+		reason := errors.New(fakerInstance.Lorem().Sentence(6))
+
+		rt := NewRuntime(WithUnhandledRejectionHandler(func(_ *Promise, reason error) {
+			reports <- reason
+		}))
+
+		rt.Reject(reason)
+
+		reported, ok := awaitReport(reports, time.Second)
+
+		require.True(t, ok)
+		require.Same(t, reason, reported)
+	})
+
+	t.Run("Reports rejections of derived promises", func(t *testing.T) {
+		reports := make(chan error, 10)
+		reason := errors.New(fakerInstance.Lorem().Sentence(6))
+
+		rt := NewRuntime(WithUnhandledRejectionHandler(func(_ *Promise, reason error) {
+			reports <- reason
+		}))
+
+		rt.Resolve(fakerInstance.Int()).Then(func(_ interface{}) (interface{}, error) {
+			return nil, reason
+		})
+
+		reported, ok := awaitReport(reports, time.Second)
+
+		require.True(t, ok)
+		require.Same(t, reason, reported)
+	})
+
+	t.Run("Does not report handled rejections", func(t *testing.T) {
+		reports := make(chan error, 10)
+
+		rt := NewRuntime(WithUnhandledRejectionHandler(func(_ *Promise, reason error) {
+			reports <- reason
+		}))
+
+		rt.Reject(errors.New(fakerInstance.Lorem().Sentence(6))).Catch(func(_ error) {})
+
+		_, ok := awaitReport(reports, 100*time.Millisecond)
+
+		require.False(t, ok)
+	})
+}
+
+func TestAll(t *testing.T) {
+	fakerInstance := faker.New()
+
+	t.Run("Fulfills with values in order", func(t *testing.T) {
+		first, second := Pending(), Pending()
+
+		promise := All(first, Resolve("resolved"), second)
+
+		require.NoError(t, second.Resolve(2))
+		require.Equal(t, StatePending, promise.State())
+
+		require.NoError(t, first.Resolve(1))
+		require.Equal(t, StateFulfilled, promise.State())
+		require.Equal(t, []interface{}{1, "resolved", 2}, promise.value)
+	})
+
+	t.Run("Rejects with the first rejection", func(t *testing.T) {
+		reason := errors.New(fakerInstance.Lorem().Sentence(6))
+		first, second := Pending(), Pending()
+
+		promise := All(first, second)
+
+		require.NoError(t, second.Reject(reason))
+		require.NoError(t, first.Reject(errors.New(fakerInstance.Lorem().Sentence(6))))
+
+		require.Equal(t, StateRejected, promise.State())
+		require.Same(t, reason, promise.err)
+	})
+
+	t.Run("Fulfills immediately without promises", func(t *testing.T) {
+		promise := All()
+
+		require.Equal(t, StateFulfilled, promise.State())
+		require.Equal(t, []interface{}{}, promise.value)
+	})
+}
+
+func TestRace(t *testing.T) {
+	fakerInstance := faker.New()
+
+	t.Run("Settles like the first settled promise", func(t *testing.T) {
+		value := fakerInstance.Int()
+		first, second := Pending(), Pending()
+
+		promise := Race(first, second)
+
+		require.NoError(t, second.Resolve(value))
+		require.NoError(t, first.Reject(errors.New(fakerInstance.Lorem().Sentence(6))))
+
+		require.Equal(t, StateFulfilled, promise.State())
+		require.Equal(t, value, promise.value)
+	})
+
+	t.Run("Rejects when the first settled promise rejects", func(t *testing.T) {
+		reason := errors.New(fakerInstance.Lorem().Sentence(6))
+		first, second := Pending(), Pending()
+
+		promise := Race(first, second)
+
+		require.NoError(t, first.Reject(reason))
+		require.NoError(t, second.Resolve(fakerInstance.Int()))
+
+		require.Equal(t, StateRejected, promise.State())
+		require.Same(t, reason, promise.err)
+	})
+
+	t.Run("Stays pending without promises", func(t *testing.T) {
+		require.Equal(t, StatePending, Race().State())
+	})
+}
@@ -0,0 +1,111 @@
+package promise
+
+import (
+	"context"
+	"sync"
+)
+
+type Scope struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	rt     *Runtime
+
+	members sync.WaitGroup
+
+	errOnce sync.Once
+	err     error
+}
+
+func RunScope(ctx context.Context, fn func(s *Scope) error) error {
+	return defaultRuntime.RunScope(ctx, fn)
+}
+
+func (rt *Runtime) RunScope(ctx context.Context, fn func(s *Scope) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	s := &Scope{
+		ctx:    ctx,
+		cancel: cancel,
+		rt:     rt,
+	}
+
+	if err := fn(s); nil != err {
+		s.fail(err)
+	}
+
+	s.members.Wait()
+
+	return s.err
+}
+
+func (s *Scope) Context() context.Context {
+	return s.ctx
+}
+
+func (s *Scope) NewPromise(callback func(resolve Resolver, reject Rejector), opts ...Option) *Promise {
+	s.members.Add(2)
+
+	p := s.rt.NewPromise(func(resolve Resolver, reject Rejector) {
+		defer s.members.Done()
+
+		callback(resolve, reject)
+	}, append(append([]Option(nil), opts...), s.withContext())...)
+
+	p.mutex.Lock()
+	p.handled = true
+	p.mutex.Unlock()
+
+	p.onSettled(func() {
+		defer s.members.Done()
+
+		if StateRejected == p.state {
+			s.fail(p.err)
+		}
+	})
+
+	return p
+}
+
+func (s *Scope) Go(task func(ctx context.Context) (interface{}, error)) *Promise {
+	return s.NewPromise(func(resolve Resolver, reject Rejector) {
+		value, err := task(s.ctx)
+		if nil != err {
+			reject(err)
+
+			return
+		}
+
+		resolve(value)
+	})
+}
+
+func (s *Scope) withContext() Option {
+	return func(o *options) {
+		if nil == o.ctx || s.ctx == o.ctx {
+			o.ctx = s.ctx
+
+			return
+		}
+
+		ctx, cancel := context.WithCancel(o.ctx)
+
+		go func() {
+			select {
+			case <-s.ctx.Done():
+				cancel()
+
+			case <-ctx.Done():
+			}
+		}()
+
+		o.ctx = ctx
+	}
+}
+
+func (s *Scope) fail(err error) {
+	s.errOnce.Do(func() {
+		s.err = err
+		s.cancel()
+	})
+}
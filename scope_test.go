@@ -0,0 +1,191 @@
+package promise
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jaswdr/faker"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunScope(t *testing.T) {
+	fakerInstance := faker.New()
+
+	t.Run("Waits for all members to settle", func(t *testing.T) {
+		var finished int32
+
+		var members []*Promise
+
+		err := RunScope(context.Background(), func(s *Scope) error {
+			members = append(members, s.Go(func(_ context.Context) (interface{}, error) {
+				time.Sleep(20 * time.Millisecond)
+
+				atomic.AddInt32(&finished, 1)
+
+				return 1, nil
+			}))
+
+			members = append(members, s.NewPromise(func(resolve Resolver, _ Rejector) {
+				time.Sleep(40 * time.Millisecond)
+
+				atomic.AddInt32(&finished, 1)
+
+				resolve(2)
+			}))
+
+			return nil
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, int32(2), atomic.LoadInt32(&finished))
+
+		for i, member := range members {
+			require.Equal(t, StateFulfilled, member.State())
+			require.Equal(t, i+1, member.value)
+		}
+	})
+
+	t.Run("Cancels remaining members on the first rejection", func(t *testing.T) {
+		reason := errors.New(fakerInstance.Lorem().Sentence(6))
+
+		var slowMember *Promise
+
+		err := RunScope(context.Background(), func(s *Scope) error {
+			s.Go(func(_ context.Context) (interface{}, error) {
+				time.Sleep(10 * time.Millisecond)
+
+				return nil, reason
+			})
+
+			slowMember = s.Go(func(ctx context.Context) (interface{}, error) {
+				select {
+				case <-ctx.Done():
+					return nil, ctx.Err()
+
+				case <-time.After(time.Second):
+					return nil, nil
+				}
+			})
+
+			return nil
+		})
+
+		require.Same(t, reason, err)
+		require.Equal(t, StateRejected, slowMember.State())
+		require.ErrorIs(t, slowMember.err, context.Canceled)
+	})
+
+	t.Run("Waits for executors of canceled members", func(t *testing.T) {
+		reason := errors.New(fakerInstance.Lorem().Sentence(6))
+
+		var finished int32
+
+		err := RunScope(context.Background(), func(s *Scope) error {
+			s.NewPromise(func(resolve Resolver, _ Rejector) {
+				time.Sleep(30 * time.Millisecond)
+
+				atomic.AddInt32(&finished, 1)
+
+				resolve(nil)
+			})
+
+			return reason
+		})
+
+		require.Same(t, reason, err)
+		require.Equal(t, int32(1), atomic.LoadInt32(&finished))
+	})
+
+	t.Run("Cancels members when parent context is done", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+
+		err := RunScope(ctx, func(s *Scope) error {
+			s.Go(func(ctx context.Context) (interface{}, error) {
+				<-ctx.Done()
+
+				return nil, ctx.Err()
+			})
+
+			cancel()
+
+			return nil
+		})
+
+		require.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("Cancels members created with their own context", func(t *testing.T) {
+		reason := errors.New(fakerInstance.Lorem().Sentence(6))
+
+		var member *Promise
+
+		done := make(chan error, 1)
+
+		go func() {
+			done <- RunScope(context.Background(), func(s *Scope) error {
+				member = s.NewPromise(func(_ Resolver, _ Rejector) {}, WithContext(context.Background()))
+
+				s.Go(func(_ context.Context) (interface{}, error) {
+					return nil, reason
+				})
+
+				return nil
+			})
+		}()
+
+		select {
+		case err := <-done:
+			require.Same(t, reason, err)
+			require.Equal(t, StateRejected, member.State())
+			require.ErrorIs(t, member.err, context.Canceled)
+
+		case <-time.After(time.Second):
+			require.FailNow(t, "Scope did not cancel member created with its own context")
+		}
+	})
+
+	t.Run("Keeps contexts of members", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		var member *Promise
+
+		err := RunScope(context.Background(), func(s *Scope) error {
+			member = s.NewPromise(func(_ Resolver, _ Rejector) {}, WithContext(ctx))
+
+			return nil
+		})
+
+		require.ErrorIs(t, err, context.Canceled)
+		require.Equal(t, StateRejected, member.State())
+	})
+
+	t.Run("Exposes its context", func(t *testing.T) {
+		err := RunScope(context.Background(), func(s *Scope) error {
+			require.NoError(t, s.Context().Err())
+
+			return nil
+		})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("Uses the runtime it was started with", func(t *testing.T) {
+		rt := NewRuntime(WithName("scoped"))
+
+		var member *Promise
+
+		require.NoError(t, rt.RunScope(context.Background(), func(s *Scope) error {
+			member = s.Go(func(_ context.Context) (interface{}, error) {
+				return nil, nil
+			})
+
+			return nil
+		}))
+
+		require.Equal(t, "scoped", member.Name())
+	})
+}
@@ -0,0 +1,134 @@
+package promise
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+type Tracer interface {
+	PromiseCreated(promise *Promise)
+	PromiseChained(parent *Promise, child *Promise, kind HandlerKind)
+	HandlerStarted(parent *Promise, child *Promise, kind HandlerKind)
+	HandlerFinished(parent *Promise, child *Promise, kind HandlerKind)
+	PromiseSettled(promise *Promise)
+}
+
+type TraceEventType string
+
+const (
+	TraceEventCreated         = TraceEventType("created")
+	TraceEventChained         = TraceEventType("chained")
+	TraceEventHandlerStarted  = TraceEventType("handler_started")
+	TraceEventHandlerFinished = TraceEventType("handler_finished")
+	TraceEventSettled         = TraceEventType("settled")
+)
+
+type TraceEvent struct {
+	Type      TraceEventType
+	Time      time.Time
+	PromiseID uint64
+	ParentID  uint64
+	Name      string
+	Handler   HandlerKind
+	State     State
+}
+
+var defaultTracer atomic.Value
+
+type tracerHolder struct {
+	tracer Tracer
+}
+
+func SetTracer(tracer Tracer) {
+	defaultTracer.Store(tracerHolder{tracer: tracer})
+}
+
+func currentTracer() Tracer {
+	if holder, ok := defaultTracer.Load().(tracerHolder); ok {
+		return holder.tracer
+	}
+
+	return nil
+}
+
+func NewTraceRecorder() *TraceRecorder {
+	return &TraceRecorder{}
+}
+
+type TraceRecorder struct {
+	mutex  sync.Mutex
+	events []TraceEvent
+}
+
+func (r *TraceRecorder) PromiseCreated(promise *Promise) {
+	r.record(TraceEvent{
+		Type:      TraceEventCreated,
+		PromiseID: promise.ID(),
+		Name:      promise.Name(),
+		State:     promise.State(),
+	})
+}
+
+func (r *TraceRecorder) PromiseChained(parent *Promise, child *Promise, kind HandlerKind) {
+	r.record(TraceEvent{
+		Type:      TraceEventChained,
+		PromiseID: child.ID(),
+		ParentID:  parent.ID(),
+		Name:      child.Name(),
+		Handler:   kind,
+	})
+}
+
+func (r *TraceRecorder) HandlerStarted(parent *Promise, child *Promise, kind HandlerKind) {
+	r.record(TraceEvent{
+		Type:      TraceEventHandlerStarted,
+		PromiseID: child.ID(),
+		ParentID:  parent.ID(),
+		Name:      child.Name(),
+		Handler:   kind,
+	})
+}
+
+func (r *TraceRecorder) HandlerFinished(parent *Promise, child *Promise, kind HandlerKind) {
+	r.record(TraceEvent{
+		Type:      TraceEventHandlerFinished,
+		PromiseID: child.ID(),
+		ParentID:  parent.ID(),
+		Name:      child.Name(),
+		Handler:   kind,
+	})
+}
+
+func (r *TraceRecorder) PromiseSettled(promise *Promise) {
+	r.record(TraceEvent{
+		Type:      TraceEventSettled,
+		PromiseID: promise.ID(),
+		Name:      promise.Name(),
+		State:     promise.State(),
+	})
+}
+
+func (r *TraceRecorder) Events() []TraceEvent {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	events := make([]TraceEvent, len(r.events))
+	copy(events, r.events)
+
+	return events
+}
+
+func (r *TraceRecorder) Reset() {
+	r.mutex.Lock()
+	r.events = nil
+	r.mutex.Unlock()
+}
+
+func (r *TraceRecorder) record(event TraceEvent) {
+	event.Time = time.Now()
+
+	r.mutex.Lock()
+	r.events = append(r.events, event)
+	r.mutex.Unlock()
+}
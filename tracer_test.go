@@ -0,0 +1,141 @@
+package promise
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jaswdr/faker"
+	"github.com/stretchr/testify/require"
+)
+
+type traceEventSummary struct {
+	Type      TraceEventType
+	PromiseID uint64
+	ParentID  uint64
+	Handler   HandlerKind
+	State     State
+}
+
+func summarizeTraceEvents(events []TraceEvent) []traceEventSummary {
+	summaries := make([]traceEventSummary, 0, len(events))
+
+	for _, event := range events {
+		summaries = append(summaries, traceEventSummary{
+			Type:      event.Type,
+			PromiseID: event.PromiseID,
+			ParentID:  event.ParentID,
+			Handler:   event.Handler,
+			State:     event.State,
+		})
+	}
+
+	return summaries
+}
+
+func TestSetTracer(t *testing.T) {
+	fakerInstance := faker.New()
+
+	t.Run("Traces promise chain", func(t *testing.T) {
+		recorder := NewTraceRecorder()
+
+		SetTracer(recorder)
+		defer SetTracer(nil)
+
+		promise := Pending()
+		thenPromise := promise.Then(func(value interface{}) (interface{}, error) {
+			return value, nil
+		}).(*Promise)
+
+		require.NoError(t, promise.Resolve(fakerInstance.Int()))
+
+		require.Equal(t, []traceEventSummary{
+			{Type: TraceEventCreated, PromiseID: promise.ID(), State: StatePending},
+			{Type: TraceEventCreated, PromiseID: thenPromise.ID(), State: StateSettling},
+			{Type: TraceEventChained, PromiseID: thenPromise.ID(), ParentID: promise.ID(), Handler: HandlerThen},
+			{Type: TraceEventSettled, PromiseID: promise.ID(), State: StateFulfilled},
+			{Type: TraceEventHandlerStarted, PromiseID: thenPromise.ID(), ParentID: promise.ID(), Handler: HandlerThen},
+			{Type: TraceEventHandlerFinished, PromiseID: thenPromise.ID(), ParentID: promise.ID(), Handler: HandlerThen},
+			{Type: TraceEventSettled, PromiseID: thenPromise.ID(), State: StateFulfilled},
+		}, summarizeTraceEvents(recorder.Events()))
+	})
+
+	t.Run("Traces settled promises at creation", func(t *testing.T) {
+		recorder := NewTraceRecorder()
+
+		SetTracer(recorder)
+		defer SetTracer(nil)
+
+		resolvedPromise := Resolve(fakerInstance.Int())
+		rejectedPromise := Reject(errors.New(fakerInstance.Lorem().Sentence(6)))
+
+		require.Equal(t, []traceEventSummary{
+			{Type: TraceEventCreated, PromiseID: resolvedPromise.ID(), State: StateFulfilled},
+			{Type: TraceEventSettled, PromiseID: resolvedPromise.ID(), State: StateFulfilled},
+			{Type: TraceEventCreated, PromiseID: rejectedPromise.ID(), State: StateRejected},
+			{Type: TraceEventSettled, PromiseID: rejectedPromise.ID(), State: StateRejected},
+		}, summarizeTraceEvents(recorder.Events()))
+	})
+
+	t.Run("Traces executor settlement", func(t *testing.T) {
+		waitGroup := newWaitGroup()
+		recorder := NewTraceRecorder()
+
+		SetTracer(recorder)
+		defer SetTracer(nil)
+
+		waitGroup.Initialize("NewPromise", 1)
+
+		promise := NewPromise(func(_ Resolver, reject Rejector) {
+			defer waitGroup.Done("NewPromise")
+
+			reject(errors.New(fakerInstance.Lorem().Sentence(6)))
+		})
+
+		waitGroup.Wait("NewPromise")
+
+		require.Equal(t, []traceEventSummary{
+			{Type: TraceEventCreated, PromiseID: promise.ID(), State: StateSettling},
+			{Type: TraceEventSettled, PromiseID: promise.ID(), State: StateRejected},
+		}, summarizeTraceEvents(recorder.Events()))
+	})
+
+	t.Run("Derived promises keep tracer of their parent", func(t *testing.T) {
+		recorder := NewTraceRecorder()
+
+		SetTracer(recorder)
+		promise := Pending()
+		SetTracer(nil)
+
+		thenPromise := promise.Then(func(value interface{}) (interface{}, error) {
+			return value, nil
+		}).(*Promise)
+
+		Pending()
+
+		require.Equal(t, []traceEventSummary{
+			{Type: TraceEventCreated, PromiseID: promise.ID(), State: StatePending},
+			{Type: TraceEventCreated, PromiseID: thenPromise.ID(), State: StateSettling},
+			{Type: TraceEventChained, PromiseID: thenPromise.ID(), ParentID: promise.ID(), Handler: HandlerThen},
+		}, summarizeTraceEvents(recorder.Events()))
+	})
+
+	t.Run("Recorder can be reset", func(t *testing.T) {
+		recorder := NewTraceRecorder()
+
+		SetTracer(recorder)
+		defer SetTracer(nil)
+
+		Pending()
+		recorder.Reset()
+
+		require.Empty(t, recorder.Events())
+
+		Pending().Named(fakerInstance.Lorem().Word())
+
+		events := recorder.Events()
+
+		require.Len(t, events, 1)
+		require.WithinDuration(t, time.Now(), events[0].Time, time.Second)
+	})
+}
@@ -0,0 +1,254 @@
+package promise
+
+import (
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	creationSiteDepth = 8
+	maxStackDepth     = 32
+)
+
+var (
+	trackersMutex   sync.RWMutex
+	trackers        = make(map[*Tracker]struct{})
+	trackersEnabled int32
+)
+
+type PromiseInfo struct {
+	ID              uint64            `json:"id"`
+	Name            string            `json:"name,omitempty"`
+	Labels          map[string]string `json:"labels,omitempty"`
+	State           State             `json:"state"`
+	CreatedAt       time.Time         `json:"created_at"`
+	Age             time.Duration     `json:"age"`
+	Handlers        int               `json:"handlers"`
+	ExecutorRunning bool              `json:"executor_running"`
+	CreationStack   string            `json:"creation_stack"`
+}
+
+type Tracker struct {
+	mutex sync.Mutex
+
+	promises  map[*Promise]struct{}
+	executors map[*Promise]struct{}
+	handlers  map[*handlerRun]struct{}
+}
+
+type handlerRun struct {
+	parent    *Promise
+	promise   *Promise
+	kind      HandlerKind
+	startedAt time.Time
+}
+
+func StartTracking() *Tracker {
+	tracker := &Tracker{
+		promises:  make(map[*Promise]struct{}),
+		executors: make(map[*Promise]struct{}),
+		handlers:  make(map[*handlerRun]struct{}),
+	}
+
+	trackersMutex.Lock()
+	trackers[tracker] = struct{}{}
+	atomic.StoreInt32(&trackersEnabled, 1)
+	trackersMutex.Unlock()
+
+	return tracker
+}
+
+func (t *Tracker) Stop() {
+	trackersMutex.Lock()
+	delete(trackers, t)
+	if 0 == len(trackers) {
+		atomic.StoreInt32(&trackersEnabled, 0)
+	}
+	trackersMutex.Unlock()
+}
+
+func (t *Tracker) UnsettledPromises() []*Promise {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	return sortedPromises(t.promises)
+}
+
+func (t *Tracker) RunningExecutors() []*Promise {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	return sortedPromises(t.executors)
+}
+
+func (t *Tracker) runningHandlers() []*handlerRun {
+	t.mutex.Lock()
+	runs := make([]*handlerRun, 0, len(t.handlers))
+	for run := range t.handlers {
+		runs = append(runs, run)
+	}
+	t.mutex.Unlock()
+
+	sort.Slice(runs, func(i, j int) bool {
+		return runs[i].startedAt.Before(runs[j].startedAt)
+	})
+
+	return runs
+}
+
+func (t *Tracker) Snapshot() []PromiseInfo {
+	t.mutex.Lock()
+	promises := sortedPromises(t.promises)
+	executors := make(map[*Promise]bool, len(t.executors))
+	for p := range t.executors {
+		executors[p] = true
+	}
+	t.mutex.Unlock()
+
+	now := time.Now()
+	snapshot := make([]PromiseInfo, 0, len(promises))
+
+	for _, p := range promises {
+		p.mutex.RLock()
+		info := PromiseInfo{
+			ID:              p.id,
+			Name:            p.name,
+			Labels:          p.labels,
+			State:           p.state,
+			CreatedAt:       p.createdAt,
+			Age:             now.Sub(p.createdAt),
+			Handlers:        len(p.handlers),
+			ExecutorRunning: executors[p],
+			CreationStack:   p.CreationStack(),
+		}
+		p.mutex.RUnlock()
+
+		snapshot = append(snapshot, info)
+	}
+
+	return snapshot
+}
+
+func (p *Promise) CreationStack() string {
+	if !p.stackIsFull {
+		return ""
+	}
+
+	var stack strings.Builder
+
+	frames := runtime.CallersFrames(p.stack)
+
+	for {
+		frame, more := frames.Next()
+
+		stack.WriteString(frame.Function)
+		stack.WriteString("\n\t")
+		stack.WriteString(frame.File)
+		stack.WriteString(":")
+		stack.WriteString(strconv.Itoa(frame.Line))
+		stack.WriteString("\n")
+
+		if !more {
+			break
+		}
+	}
+
+	return stack.String()
+}
+
+func isTracking() bool {
+	return 0 != atomic.LoadInt32(&trackersEnabled)
+}
+
+func track(p *Promise) {
+	forEachTracker(func(tracker *Tracker) {
+		tracker.promises[p] = struct{}{}
+	})
+}
+
+func untrack(p *Promise) {
+	if !isTracking() {
+		return
+	}
+
+	forEachTracker(func(tracker *Tracker) {
+		delete(tracker.promises, p)
+	})
+}
+
+func trackExecutor(p *Promise) {
+	if !isTracking() {
+		return
+	}
+
+	forEachTracker(func(tracker *Tracker) {
+		tracker.executors[p] = struct{}{}
+	})
+}
+
+func untrackExecutor(p *Promise) {
+	if !isTracking() {
+		return
+	}
+
+	forEachTracker(func(tracker *Tracker) {
+		delete(tracker.executors, p)
+	})
+}
+
+func trackHandler(parent *Promise, promise *Promise, kind HandlerKind) *handlerRun {
+	run := &handlerRun{
+		parent:    parent,
+		promise:   promise,
+		kind:      kind,
+		startedAt: time.Now(),
+	}
+
+	forEachTracker(func(tracker *Tracker) {
+		tracker.handlers[run] = struct{}{}
+	})
+
+	return run
+}
+
+func untrackHandler(run *handlerRun) {
+	forEachTracker(func(tracker *Tracker) {
+		delete(tracker.handlers, run)
+	})
+}
+
+func forEachTracker(callback func(tracker *Tracker)) {
+	trackersMutex.RLock()
+	defer trackersMutex.RUnlock()
+
+	for tracker := range trackers {
+		tracker.mutex.Lock()
+		callback(tracker)
+		tracker.mutex.Unlock()
+	}
+}
+
+func callers(skip int, depth int) []uintptr {
+	stack := make([]uintptr, depth)
+
+	return stack[:runtime.Callers(skip+1, stack)]
+}
+
+func sortedPromises(promises map[*Promise]struct{}) []*Promise {
+	sorted := make([]*Promise, 0, len(promises))
+
+	for p := range promises {
+		sorted = append(sorted, p)
+	}
+
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].id < sorted[j].id
+	})
+
+	return sorted
+}
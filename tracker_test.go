@@ -0,0 +1,130 @@
+package promise
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jaswdr/faker"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStartTracking(t *testing.T) {
+	fakerInstance := faker.New()
+
+	t.Run("Tracks unsettled promises created while tracking", func(t *testing.T) {
+		Pending()
+
+		tracker := StartTracking()
+		defer tracker.Stop()
+
+		pendingPromise := Pending()
+		Resolve(fakerInstance.Int())
+		settledPromise := Pending()
+
+		require.Equal(t, []*Promise{pendingPromise, settledPromise}, tracker.UnsettledPromises())
+
+		require.NoError(t, settledPromise.Reject(errors.New(fakerInstance.Lorem().Sentence(6))))
+
+		require.Equal(t, []*Promise{pendingPromise}, tracker.UnsettledPromises())
+	})
+
+	t.Run("Tracks derived promises until they are settled", func(t *testing.T) {
+		tracker := StartTracking()
+		defer tracker.Stop()
+
+		promise := Pending()
+		thenPromise := promise.Then(func(value interface{}) (interface{}, error) {
+			return value, nil
+		})
+
+		require.Equal(t, []*Promise{promise, thenPromise.(*Promise)}, tracker.UnsettledPromises())
+
+		require.NoError(t, promise.Resolve(fakerInstance.Int()))
+
+		require.Empty(t, tracker.UnsettledPromises())
+	})
+
+	t.Run("Tracks running executors", func(t *testing.T) {
+		waitGroup := newWaitGroup()
+
+		tracker := StartTracking()
+		defer tracker.Stop()
+
+		waitGroup.
+			Initialize("root", 1).
+			Initialize("NewPromise", 1)
+
+		promise := NewPromise(func(resolve Resolver, _ Rejector) {
+			defer waitGroup.Done("NewPromise")
+
+			waitGroup.Wait("root")
+
+			resolve(fakerInstance.Int())
+		})
+
+		require.Equal(t, []*Promise{promise}, tracker.RunningExecutors())
+		require.Equal(t, []*Promise{promise}, tracker.UnsettledPromises())
+
+		waitGroup.Done("root")
+		waitGroup.Wait("NewPromise")
+		time.Sleep(time.Millisecond * 50)
+
+		require.Empty(t, tracker.RunningExecutors())
+		require.Empty(t, tracker.UnsettledPromises())
+	})
+
+	t.Run("Captures creation stack of tracked promises", func(t *testing.T) {
+		tracker := StartTracking()
+		defer tracker.Stop()
+
+		require.Contains(t, Pending().CreationStack(), "promise.TestStartTracking")
+	})
+
+	t.Run("Snapshot describes unsettled promises", func(t *testing.T) {
+		waitGroup := newWaitGroup()
+
+		tracker := StartTracking()
+		defer tracker.Stop()
+
+		waitGroup.Initialize("root", 1)
+
+		defer waitGroup.Done("root")
+
+		pendingPromise := Pending()
+		pendingPromise.Finally(func() {})
+		pendingPromise.Finally(func() {})
+
+		settlingPromise := NewPromise(func(_ Resolver, _ Rejector) {
+			waitGroup.Wait("root")
+		})
+
+		time.Sleep(time.Millisecond * 10)
+
+		snapshot := tracker.Snapshot()
+
+		require.Len(t, snapshot, 4)
+
+		require.Equal(t, pendingPromise.ID(), snapshot[0].ID)
+		require.Equal(t, StatePending, snapshot[0].State)
+		require.Equal(t, 2, snapshot[0].Handlers)
+		require.False(t, snapshot[0].ExecutorRunning)
+		require.GreaterOrEqual(t, int64(snapshot[0].Age), int64(time.Millisecond*10))
+		require.Contains(t, snapshot[0].CreationStack, "promise.TestStartTracking")
+
+		require.Equal(t, settlingPromise.ID(), snapshot[3].ID)
+		require.Equal(t, StateSettling, snapshot[3].State)
+		require.Equal(t, 0, snapshot[3].Handlers)
+		require.True(t, snapshot[3].ExecutorRunning)
+	})
+
+	t.Run("Stopped tracker does not track new promises", func(t *testing.T) {
+		tracker := StartTracking()
+		tracker.Stop()
+
+		promise := Pending()
+
+		require.Empty(t, tracker.UnsettledPromises())
+		require.Empty(t, promise.CreationStack())
+	})
+}
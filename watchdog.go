@@ -0,0 +1,187 @@
+package promise
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	WatchdogSlowHandler = WatchdogReportKind("slow_handler")
+	WatchdogLongPending = WatchdogReportKind("long_pending")
+
+	minWatchdogCheckInterval = time.Millisecond
+)
+
+type WatchdogReportKind string
+
+type WatchdogReport struct {
+	Kind          WatchdogReportKind
+	PromiseID     uint64
+	ParentID      uint64
+	Name          string
+	Handler       HandlerKind
+	Duration      time.Duration
+	CreationStack string
+}
+
+type WatchdogConfig struct {
+	SlowHandlerThreshold time.Duration
+	LongPendingThreshold time.Duration
+	CheckInterval        time.Duration
+	OnReport             func(report WatchdogReport)
+}
+
+type Watchdog struct {
+	config  WatchdogConfig
+	tracker *Tracker
+
+	stopOnce sync.Once
+	stop     chan struct{}
+	done     chan struct{}
+
+	reportedPromises map[*Promise]struct{}
+	reportedHandlers map[*handlerRun]struct{}
+}
+
+func StartWatchdog(config WatchdogConfig) *Watchdog {
+	if 0 >= config.CheckInterval {
+		config.CheckInterval = watchdogCheckInterval(config)
+	}
+
+	w := &Watchdog{
+		config:           config,
+		tracker:          StartTracking(),
+		stop:             make(chan struct{}),
+		done:             make(chan struct{}),
+		reportedPromises: make(map[*Promise]struct{}),
+		reportedHandlers: make(map[*handlerRun]struct{}),
+	}
+
+	go w.run()
+
+	return w
+}
+
+func (w *Watchdog) Stop() {
+	w.stopOnce.Do(func() {
+		close(w.stop)
+		<-w.done
+
+		w.tracker.Stop()
+	})
+}
+
+func (w *Watchdog) run() {
+	defer close(w.done)
+
+	ticker := time.NewTicker(w.config.CheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-w.stop:
+			return
+
+		case now := <-ticker.C:
+			w.check(now)
+		}
+	}
+}
+
+func (w *Watchdog) check(now time.Time) {
+	if 0 < w.config.SlowHandlerThreshold {
+		w.checkHandlers(now)
+	}
+
+	if 0 < w.config.LongPendingThreshold {
+		w.checkPromises(now)
+	}
+}
+
+func (w *Watchdog) checkHandlers(now time.Time) {
+	running := make(map[*handlerRun]struct{})
+
+	for _, run := range w.tracker.runningHandlers() {
+		running[run] = struct{}{}
+
+		if _, reported := w.reportedHandlers[run]; reported {
+			continue
+		}
+
+		duration := now.Sub(run.startedAt)
+		if duration < w.config.SlowHandlerThreshold {
+			continue
+		}
+
+		w.reportedHandlers[run] = struct{}{}
+
+		w.report(WatchdogReport{
+			Kind:          WatchdogSlowHandler,
+			PromiseID:     run.promise.ID(),
+			ParentID:      run.parent.ID(),
+			Name:          run.promise.Name(),
+			Handler:       run.kind,
+			Duration:      duration,
+			CreationStack: run.promise.CreationStack(),
+		})
+	}
+
+	for run := range w.reportedHandlers {
+		if _, isRunning := running[run]; !isRunning {
+			delete(w.reportedHandlers, run)
+		}
+	}
+}
+
+func (w *Watchdog) checkPromises(now time.Time) {
+	unsettled := make(map[*Promise]struct{})
+
+	for _, p := range w.tracker.UnsettledPromises() {
+		unsettled[p] = struct{}{}
+
+		if _, reported := w.reportedPromises[p]; reported {
+			continue
+		}
+
+		duration := now.Sub(p.createdAt)
+		if duration < w.config.LongPendingThreshold {
+			continue
+		}
+
+		w.reportedPromises[p] = struct{}{}
+
+		w.report(WatchdogReport{
+			Kind:          WatchdogLongPending,
+			PromiseID:     p.ID(),
+			Name:          p.Name(),
+			Duration:      duration,
+			CreationStack: p.CreationStack(),
+		})
+	}
+
+	for p := range w.reportedPromises {
+		if _, isUnsettled := unsettled[p]; !isUnsettled {
+			delete(w.reportedPromises, p)
+		}
+	}
+}
+
+func (w *Watchdog) report(report WatchdogReport) {
+	if nil != w.config.OnReport {
+		w.config.OnReport(report)
+	}
+}
+
+func watchdogCheckInterval(config WatchdogConfig) time.Duration {
+	interval := config.SlowHandlerThreshold
+	if 0 >= interval || (0 < config.LongPendingThreshold && config.LongPendingThreshold < interval) {
+		interval = config.LongPendingThreshold
+	}
+
+	interval /= 4
+	if interval < minWatchdogCheckInterval {
+		interval = minWatchdogCheckInterval
+	}
+
+	return interval
+}
@@ -0,0 +1,129 @@
+package promise
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jaswdr/faker"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStartWatchdog(t *testing.T) {
+	fakerInstance := faker.New()
+
+	t.Run("Reports slow handlers", func(t *testing.T) {
+		reports := make(chan WatchdogReport, 10)
+		release := make(chan struct{})
+
+		watchdog := StartWatchdog(WatchdogConfig{
+			SlowHandlerThreshold: 20 * time.Millisecond,
+			OnReport: func(report WatchdogReport) {
+				reports <- report
+			},
+		})
+		defer watchdog.Stop()
+
+		promise := Pending().Named("fetch")
+		thenPromise := promise.Then(func(value interface{}) (interface{}, error) {
+			<-release
+
+			return value, nil
+		}).(*Promise)
+
+		go func() {
+			_ = promise.Resolve(fakerInstance.Int())
+		}()
+
+		select {
+		case report := <-reports:
+			close(release)
+
+			require.Equal(t, WatchdogSlowHandler, report.Kind)
+			require.Equal(t, thenPromise.ID(), report.PromiseID)
+			require.Equal(t, promise.ID(), report.ParentID)
+			require.Equal(t, "fetch.then", report.Name)
+			require.Equal(t, HandlerThen, report.Handler)
+			require.GreaterOrEqual(t, int64(report.Duration), int64(20*time.Millisecond))
+			require.Contains(t, report.CreationStack, "watchdog_test.go")
+
+		case <-time.After(time.Second):
+			close(release)
+
+			require.Fail(t, "Slow handler was not reported")
+		}
+
+		time.Sleep(50 * time.Millisecond)
+
+		require.Empty(t, reports)
+	})
+
+	t.Run("Reports long pending promises once", func(t *testing.T) {
+		reports := make(chan WatchdogReport, 10)
+
+		watchdog := StartWatchdog(WatchdogConfig{
+			LongPendingThreshold: 20 * time.Millisecond,
+			OnReport: func(report WatchdogReport) {
+				reports <- report
+			},
+		})
+		defer watchdog.Stop()
+
+		promise := Pending().Named("slow")
+		settledPromise := Pending()
+
+		require.NoError(t, settledPromise.Resolve(fakerInstance.Int()))
+
+		select {
+		case report := <-reports:
+			require.Equal(t, WatchdogLongPending, report.Kind)
+			require.Equal(t, promise.ID(), report.PromiseID)
+			require.Equal(t, "slow", report.Name)
+			require.Empty(t, report.Handler)
+			require.GreaterOrEqual(t, int64(report.Duration), int64(20*time.Millisecond))
+			require.Contains(t, report.CreationStack, "watchdog_test.go")
+
+		case <-time.After(time.Second):
+			require.Fail(t, "Long pending promise was not reported")
+		}
+
+		time.Sleep(50 * time.Millisecond)
+
+		require.Empty(t, reports)
+	})
+
+	t.Run("Does not report after being stopped", func(t *testing.T) {
+		reports := make(chan WatchdogReport, 10)
+
+		watchdog := StartWatchdog(WatchdogConfig{
+			LongPendingThreshold: 20 * time.Millisecond,
+			OnReport: func(report WatchdogReport) {
+				reports <- report
+			},
+		})
+
+		Pending()
+
+		watchdog.Stop()
+		watchdog.Stop()
+
+		time.Sleep(50 * time.Millisecond)
+
+		require.Empty(t, reports)
+	})
+}
+
+func TestWatchdogCheckInterval(t *testing.T) {
+	for _, tt := range []struct {
+		config   WatchdogConfig
+		expected time.Duration
+	}{
+		{config: WatchdogConfig{SlowHandlerThreshold: time.Second}, expected: 250 * time.Millisecond},
+		{config: WatchdogConfig{LongPendingThreshold: time.Second}, expected: 250 * time.Millisecond},
+		{config: WatchdogConfig{SlowHandlerThreshold: time.Second, LongPendingThreshold: 100 * time.Millisecond}, expected: 25 * time.Millisecond},
+		{config: WatchdogConfig{SlowHandlerThreshold: 100 * time.Millisecond, LongPendingThreshold: time.Second}, expected: 25 * time.Millisecond},
+		{config: WatchdogConfig{SlowHandlerThreshold: time.Microsecond}, expected: time.Millisecond},
+		{config: WatchdogConfig{}, expected: time.Millisecond},
+	} {
+		require.Equal(t, tt.expected, watchdogCheckInterval(tt.config))
+	}
+}